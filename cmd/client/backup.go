@@ -0,0 +1,189 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// Backup statuses reported by Altinity.Cloud API.
+const (
+	BackupStatusInProgress = "in_progress"
+	BackupStatusCompleted  = "completed"
+	BackupStatusFailed     = "failed"
+)
+
+// GetBackupSchedule - Returns the backup schedule of a cluster from Altinity.Cloud API.
+func (c *AltinityCloudClient) GetBackupSchedule(ctx context.Context, clusterID string) (BackupSchedule, error) {
+	requestURL := fmt.Sprintf("%s/cluster/%s/backupschedule", c.APIEndpoint, clusterID)
+	req, err := http.NewRequestWithContext(ctx, "GET", requestURL, nil)
+	if err != nil {
+		return BackupSchedule{}, err
+	}
+
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return BackupSchedule{}, err
+	}
+
+	br := BackupScheduleResponse{}
+	err = json.Unmarshal(body, &br)
+	if err != nil {
+		return BackupSchedule{}, err
+	}
+
+	return br.Data, nil
+}
+
+// SetBackupSchedule - Creates or replaces the backup schedule of a cluster.
+func (c *AltinityCloudClient) SetBackupSchedule(ctx context.Context, schedule BackupSchedule) (BackupSchedule, error) {
+	// build the POST request
+	requestURL := fmt.Sprintf("%s/cluster/%s/backupschedule", c.APIEndpoint, schedule.ClusterID)
+	req, err := http.NewRequestWithContext(ctx, "POST", requestURL, nil)
+	if err != nil {
+		return BackupSchedule{}, err
+	}
+
+	// replacing the schedule is safe to retry
+	req = retryablePost(req)
+
+	// add the query params
+	q := req.URL.Query()
+	q.Add("schedule", schedule.Schedule)
+	q.Add("retention", strconv.FormatInt(schedule.Retention, 10))
+	q.Add("compression", schedule.Compression)
+
+	// add optional params bucket if not null or empty string, the environment bucket is used otherwise
+	if len(schedule.Bucket) > 0 {
+		q.Add("bucket", schedule.Bucket)
+	}
+	req.URL.RawQuery = q.Encode()
+
+	// make the request
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return BackupSchedule{}, err
+	}
+
+	// unmarshal the response
+	br := BackupScheduleResponse{}
+	err = json.Unmarshal(body, &br)
+	if err != nil {
+		return BackupSchedule{}, err
+	}
+
+	return br.Data, nil
+}
+
+// DeleteBackupSchedule - Disables scheduled backups of a cluster.
+func (c *AltinityCloudClient) DeleteBackupSchedule(ctx context.Context, clusterID string) error {
+	// build the DELETE request
+	requestURL := fmt.Sprintf("%s/cluster/%s/backupschedule", c.APIEndpoint, clusterID)
+	req, err := http.NewRequestWithContext(ctx, "DELETE", requestURL, nil)
+	if err != nil {
+		return err
+	}
+
+	// make the request
+	_, err = c.doRequest(req, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetBackups - Returns list of backups of a cluster from Altinity.Cloud API.
+func (c *AltinityCloudClient) GetBackups(ctx context.Context, clusterID string) (BackupData, error) {
+	requestURL := fmt.Sprintf("%s/cluster/%s/backups", c.APIEndpoint, clusterID)
+	req, err := http.NewRequestWithContext(ctx, "GET", requestURL, nil)
+	if err != nil {
+		return BackupData{}, err
+	}
+
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return BackupData{}, err
+	}
+
+	bd := BackupData{}
+	err = json.Unmarshal(body, &bd)
+	if err != nil {
+		return BackupData{}, err
+	}
+
+	return bd, nil
+}
+
+// GetBackup - Returns backup by ID from Altinity.Cloud API.
+func (c *AltinityCloudClient) GetBackup(ctx context.Context, ID string) (Backup, error) {
+	requestURL := fmt.Sprintf("%s/backup/%s", c.APIEndpoint, ID)
+	req, err := http.NewRequestWithContext(ctx, "GET", requestURL, nil)
+	if err != nil {
+		return Backup{}, err
+	}
+
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return Backup{}, err
+	}
+
+	br := BackupResponse{}
+	err = json.Unmarshal(body, &br)
+	if err != nil {
+		return Backup{}, err
+	}
+
+	return br.Data, nil
+}
+
+// CreateBackup - Starts a named backup of a cluster. The backup runs in the background.
+func (c *AltinityCloudClient) CreateBackup(ctx context.Context, clusterID, name string) (Backup, error) {
+	// build the POST request
+	requestURL := fmt.Sprintf("%s/cluster/%s/backups", c.APIEndpoint, clusterID)
+	req, err := http.NewRequestWithContext(ctx, "POST", requestURL, nil)
+	if err != nil {
+		return Backup{}, err
+	}
+
+	// add the query params
+	q := url.Values{}
+	q.Add("name", name)
+	req.URL.RawQuery = q.Encode()
+
+	// make the request
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return Backup{}, err
+	}
+
+	// unmarshal the response
+	br := BackupResponse{}
+	err = json.Unmarshal(body, &br)
+	if err != nil {
+		return Backup{}, err
+	}
+
+	return br.Data, nil
+}
+
+// DeleteBackup - Deletes a backup by ID from the backup storage.
+func (c *AltinityCloudClient) DeleteBackup(ctx context.Context, ID string) error {
+	// build the DELETE request
+	requestURL := fmt.Sprintf("%s/backup/%s", c.APIEndpoint, ID)
+	req, err := http.NewRequestWithContext(ctx, "DELETE", requestURL, nil)
+	if err != nil {
+		return err
+	}
+
+	// make the request
+	_, err = c.doRequest(req, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
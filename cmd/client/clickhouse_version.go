@@ -0,0 +1,66 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+// GetClickHouseVersions - Returns the ClickHouse versions available to an environment from Altinity.Cloud API.
+func (c *AltinityCloudClient) GetClickHouseVersions(ctx context.Context, envID string) (ClickHouseVersionData, error) {
+	requestURL := fmt.Sprintf("%s/environment/%s/versions", c.APIEndpoint, envID)
+	req, err := http.NewRequestWithContext(ctx, "GET", requestURL, nil)
+	if err != nil {
+		return ClickHouseVersionData{}, err
+	}
+
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return ClickHouseVersionData{}, err
+	}
+
+	vd := ClickHouseVersionData{}
+	err = json.Unmarshal(body, &vd)
+	if err != nil {
+		return ClickHouseVersionData{}, err
+	}
+
+	return vd, nil
+}
+
+// UpgradeCluster - Changes the ClickHouse version of a running cluster with a rolling upgrade.
+// The API rejects downgrades unless allowDowngrade is set.
+func (c *AltinityCloudClient) UpgradeCluster(ctx context.Context, ID, version string, allowDowngrade bool) (Cluster, error) {
+	// build the POST request
+	requestURL := fmt.Sprintf("%s/cluster/%s/upgrade", c.APIEndpoint, ID)
+	req, err := http.NewRequestWithContext(ctx, "POST", requestURL, nil)
+	if err != nil {
+		return Cluster{}, err
+	}
+
+	// upgrading to the same version is safe to retry
+	req = retryablePost(req)
+
+	// add the query params
+	q := req.URL.Query()
+	q.Add("version", version)
+	q.Add("allowDowngrade", strconv.FormatBool(allowDowngrade))
+	req.URL.RawQuery = q.Encode()
+
+	// make the request
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return Cluster{}, err
+	}
+
+	// unmarshal the response
+	cr := ClusterResponse{}
+	err = json.Unmarshal(body, &cr)
+	if err != nil {
+		return Cluster{}, err
+	}
+
+	return cr.Data, nil
+}
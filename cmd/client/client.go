@@ -1,29 +1,54 @@
 package client
 
 import (
+	"context"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"io"
+	"math/rand"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
 	"time"
 )
 
 // APIEndpoint - Altinity.Cloud default API endpoint.
 const APIEndpoint string = "https://acm.altinity.cloud/api"
 
+// Default HTTP transport settings.
+const (
+	DefaultRequestTimeout = 10 * time.Second
+	DefaultMaxRetries     = 4
+	DefaultRetryWaitMin   = 1 * time.Second
+	DefaultRetryWaitMax   = 30 * time.Second
+)
+
 // AltinityCloudClient is wrapper for http client and configs.
 type AltinityCloudClient struct {
 	HTTPClient  *http.Client
 	APIEndpoint string
 	APIToken    string
+	// MaxRetries - how many times a failed idempotent request is retried.
+	MaxRetries int
+	// RetryWaitMin and RetryWaitMax - bounds of the exponential backoff between retries.
+	RetryWaitMin time.Duration
+	RetryWaitMax time.Duration
+	// PollInterval - how long to wait between status reads of long-running operations.
+	PollInterval time.Duration
 }
 
 // NewClient - create new Altinity.Cloud client.
 func NewClient(endpoint, token *string) (*AltinityCloudClient, error) {
 	c := AltinityCloudClient{
-		HTTPClient: &http.Client{Timeout: 10 * time.Second},
+		HTTPClient: &http.Client{Timeout: DefaultRequestTimeout},
 		// Default Altinity.Cloud API endpoint
-		APIEndpoint: APIEndpoint,
-		APIToken:    "",
+		APIEndpoint:  APIEndpoint,
+		APIToken:     "",
+		MaxRetries:   DefaultMaxRetries,
+		RetryWaitMin: DefaultRetryWaitMin,
+		RetryWaitMax: DefaultRetryWaitMax,
+		PollInterval: DefaultPollInterval,
 	}
 
 	if endpoint != nil {
@@ -37,8 +62,94 @@ func NewClient(endpoint, token *string) (*AltinityCloudClient, error) {
 	return &c, nil
 }
 
+// retryablePostKey - context key marking a POST request as safe to retry.
+type retryablePostKey struct{}
+
+// retryablePost - marks a POST request as safe to retry, e.g. a full update of an existing object.
+func retryablePost(req *http.Request) *http.Request {
+	return req.WithContext(context.WithValue(req.Context(), retryablePostKey{}, true))
+}
+
+// newFormRequest - builds a request sending the params as a form body, which keeps credentials
+// out of URLs and with that out of proxy and access logs.
+func newFormRequest(ctx context.Context, method, requestURL string, params url.Values) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, method, requestURL, strings.NewReader(params.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	return req, nil
+}
+
+// isRetryable - returns true if the request can be sent more than once without side effects.
+func isRetryable(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	case http.MethodPost:
+		safe, _ := req.Context().Value(retryablePostKey{}).(bool)
+		return safe
+	}
+	return false
+}
+
+// isRetryableStatus - returns true for responses worth retrying.
+func isRetryableStatus(status int) bool {
+	switch status {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// backoff - returns how long to wait before the next attempt. Retry-After is honored when
+// present, otherwise the wait grows exponentially from RetryWaitMin up to RetryWaitMax with jitter.
+func (c *AltinityCloudClient) backoff(attempt int, res *http.Response) time.Duration {
+	if res != nil {
+		if wait, ok := parseRetryAfter(res.Header.Get("Retry-After")); ok {
+			return wait
+		}
+	}
+
+	wait := c.RetryWaitMin << attempt
+	if wait <= 0 || wait > c.RetryWaitMax {
+		wait = c.RetryWaitMax
+	}
+
+	// full jitter on the upper half of the window to avoid synchronized retries
+	half := int64(wait / 2)
+	if half <= 0 {
+		return wait
+	}
+	return time.Duration(half + rand.Int63n(half))
+}
+
+// parseRetryAfter - parses Retry-After header in either delay-seconds or HTTP-date format.
+func parseRetryAfter(header string) (time.Duration, bool) {
+	if header == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(header); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if at, err := http.ParseTime(header); err == nil {
+		wait := time.Until(at)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+
+	return 0, false
+}
+
 // doRequest - sends HTTP over the wire with correct headers and returns response.
+// Idempotent requests are retried on network errors and 429/502/503/504 responses
+// until MaxRetries is reached or the request context is done.
 func (c *AltinityCloudClient) doRequest(req *http.Request, authToken *string) ([]byte, error) { // nolint: unparam
+	ctx := req.Context()
 	token := c.APIToken
 
 	if authToken != nil {
@@ -46,22 +157,51 @@ func (c *AltinityCloudClient) doRequest(req *http.Request, authToken *string) ([
 	}
 
 	req.Header.Set("X-Auth-Token", token)
+	retryable := isRetryable(req)
 
-	res, err := c.HTTPClient.Do(req)
-	if err != nil {
-		fmt.Printf("client: could not do request: %s\n", err)
-		return nil, err
-	}
-	defer res.Body.Close()
+	for attempt := 0; ; attempt++ {
+		// rewind the request body for every new attempt
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
 
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
-	}
+		var wait time.Duration
+		res, err := c.HTTPClient.Do(req)
+		if err != nil {
+			tflog.Debug(ctx, fmt.Sprintf("client: could not do request: %s", err))
+			if ctx.Err() != nil || !retryable || attempt >= c.MaxRetries {
+				return nil, err
+			}
+			wait = c.backoff(attempt, nil)
+		} else {
+			body, err := io.ReadAll(res.Body)
+			res.Body.Close()
+			if err != nil {
+				return nil, err
+			}
 
-	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("status: %d, body: %s", res.StatusCode, body)
-	}
+			if res.StatusCode == http.StatusOK {
+				return body, nil
+			}
+
+			if !retryable || !isRetryableStatus(res.StatusCode) || attempt >= c.MaxRetries {
+				return nil, newAPIError(res, body)
+			}
+			wait = c.backoff(attempt, res)
+		}
 
-	return body, err
+		tflog.Warn(ctx, fmt.Sprintf("client: retrying %s %s in %s (attempt %d of %d)", req.Method, req.URL.Path, wait, attempt+1, c.MaxRetries))
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
 }
@@ -1,8 +1,13 @@
 package client
 
 import (
+	"context"
 	"github.com/stretchr/testify/assert"
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 )
 
 func TestNewClient(t *testing.T) {
@@ -27,3 +32,187 @@ func TestEmptyClient(t *testing.T) {
 	assert.Equal(t, "https://acm.altinity.cloud/api", valid.APIEndpoint, "Altiniy.Cloud endpoints should match")
 	assert.Equal(t, "", valid.APIToken, "Altiniy.Cloud tokens string should match")
 }
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *AltinityCloudClient {
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	endpoint := srv.URL
+	c, err := NewClient(&endpoint, nil)
+	if err != nil {
+		t.Fatalf(`NewClient(&endpoint, nil), want nil got %v`, err)
+	}
+	c.RetryWaitMin = time.Millisecond
+	c.RetryWaitMax = 5 * time.Millisecond
+
+	return c
+}
+
+func TestDoRequestRetriesIdempotent(t *testing.T) {
+	calls := 0
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":[]}`))
+	})
+
+	_, err := c.GetNodeTypes(context.Background(), "1")
+	assert.Nil(t, err)
+	assert.Equal(t, 3, calls, "GET should be retried until it succeeds")
+}
+
+func TestDoRequestDoesNotRetryCreate(t *testing.T) {
+	calls := 0
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusBadGateway)
+	})
+
+	_, err := c.CreateNodeType(context.Background(), "1", NodeType{Name: "test"})
+	assert.NotNil(t, err)
+	assert.Equal(t, 1, calls, "POST create should not be retried")
+}
+
+func TestDoRequestGivesUpAfterMaxRetries(t *testing.T) {
+	calls := 0
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+	c.MaxRetries = 2
+
+	err := c.DeleteNodeType(context.Background(), "1")
+	assert.NotNil(t, err)
+	assert.Equal(t, 3, calls, "DELETE should be sent once and retried twice")
+}
+
+func TestDoRequestHonorsContext(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	c.RetryWaitMin = time.Minute
+	c.RetryWaitMax = time.Minute
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := c.GetNodeTypes(ctx, "1")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	wait, ok := parseRetryAfter("7")
+	assert.True(t, ok)
+	assert.Equal(t, 7*time.Second, wait)
+
+	_, ok = parseRetryAfter("")
+	assert.False(t, ok)
+
+	_, ok = parseRetryAfter("soon")
+	assert.False(t, ok)
+
+	wait, ok = parseRetryAfter(time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat))
+	assert.True(t, ok)
+	assert.Equal(t, time.Duration(0), wait)
+}
+
+func TestGetNodeTypeNotFound(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":[{"id":"1","name":"other"}]}`))
+	})
+
+	_, err := c.GetNodeType(context.Background(), "1", "missing")
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.True(t, IsNotFound(err))
+
+	nt, err := c.GetNodeType(context.Background(), "1", "other")
+	assert.Nil(t, err)
+	assert.Equal(t, "1", nt.ID)
+}
+
+func TestGetEnvironmentByName(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/environments", r.URL.Path)
+		_, _ = w.Write([]byte(`{"data":[{"id":"648","name":"tatari-prod","cloud":"aws","region":"us-east-1","zones":["us-east-1a","us-east-1b"]}]}`))
+	})
+
+	env, err := c.GetEnvironmentByName(context.Background(), "tatari-prod")
+	assert.Nil(t, err)
+	assert.Equal(t, "648", env.ID)
+	assert.Equal(t, []string{"us-east-1a", "us-east-1b"}, env.Zones)
+
+	_, err = c.GetEnvironmentByName(context.Background(), "missing")
+	assert.True(t, IsNotFound(err))
+}
+
+func TestUpdateEnvironmentParams(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/environment/648", r.URL.Path)
+		assert.Equal(t, "us-east-1a,us-east-1b", r.URL.Query().Get("zones"))
+		assert.Equal(t, "m6i.xlarge", r.URL.Query().Get("nodeTypeClickHouse"))
+		assert.False(t, r.URL.Query().Has("nodeTypeZookeeper"))
+		_, _ = w.Write([]byte(`{"data":{"id":"648","name":"tatari-prod"}}`))
+	})
+
+	env, err := c.UpdateEnvironment(context.Background(), Environment{
+		ID:                        "648",
+		Name:                      "tatari-prod",
+		Zones:                     []string{"us-east-1a", "us-east-1b"},
+		DefaultClickHouseNodeType: "m6i.xlarge",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "tatari-prod", env.Name)
+}
+
+func TestCreateClusterParams(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/environment/648/clusters", r.URL.Path)
+		assert.Empty(t, r.URL.RawQuery)
+		assert.Equal(t, "application/x-www-form-urlencoded", r.Header.Get("Content-Type"))
+		assert.Nil(t, r.ParseForm())
+		assert.Equal(t, "tf-acc", r.PostForm.Get("name"))
+		assert.Equal(t, "admin-secret", r.PostForm.Get("adminPass"))
+		_, _ = w.Write([]byte(`{"data":{"id":"42","name":"tf-acc","status":"launching"}}`))
+	})
+
+	cl, err := c.CreateCluster(context.Background(), "648", Cluster{Name: "tf-acc", NodeType: "m6i.xlarge", AdminPassword: "admin-secret"})
+	assert.Nil(t, err)
+	assert.Equal(t, "42", cl.ID)
+}
+
+func TestCreateClusterUserParams(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/cluster/42/users", r.URL.Path)
+		assert.Empty(t, r.URL.RawQuery)
+		assert.Nil(t, r.ParseForm())
+		assert.Equal(t, "secret", r.PostForm.Get("password"))
+		assert.Equal(t, "10.0.0.0/8,192.168.1.1", r.PostForm.Get("networks"))
+		assert.False(t, r.PostForm.Has("databases"))
+		_, _ = w.Write([]byte(`{"data":{"id":"7","cluster":"42","name":"app","profile":"default"}}`))
+	})
+
+	u, err := c.CreateClusterUser(context.Background(), "42", ClusterUser{Name: "app", Password: "secret", Networks: []string{"10.0.0.0/8", "192.168.1.1"}})
+	assert.Nil(t, err)
+	assert.Equal(t, "7", u.ID)
+	assert.Empty(t, u.Password)
+}
+
+func TestSetClusterIPAllowlistBody(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/cluster/42/allowlist", r.URL.Path)
+		assert.Empty(t, r.URL.RawQuery)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		body, err := io.ReadAll(r.Body)
+		assert.Nil(t, err)
+		assert.JSONEq(t, `{"entries":[{"cidr":"10.0.0.0/8","description":"office"}]}`, string(body))
+		_, _ = w.Write([]byte(`{"data":[{"cidr":"10.0.0.0/8","description":"office"}]}`))
+	})
+
+	ad, err := c.SetClusterIPAllowlist(context.Background(), "42", []IPAllowlistEntry{{CIDR: "10.0.0.0/8", Description: "office"}})
+	assert.Nil(t, err)
+	assert.Len(t, ad.Entries, 1)
+}
@@ -0,0 +1,203 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// Cluster statuses reported by Altinity.Cloud API.
+const (
+	ClusterStatusOnline    = "online"
+	ClusterStatusLaunching = "launching"
+	ClusterStatusRescaling = "rescaling"
+	ClusterStatusUpgrading = "upgrading"
+	ClusterStatusFailed    = "failed"
+)
+
+// GetClusters - Returns list of clusters in an environment from Altinity.Cloud API.
+func (c *AltinityCloudClient) GetClusters(ctx context.Context, envID string) (ClusterData, error) {
+	requestURL := fmt.Sprintf("%s/environment/%s/clusters", c.APIEndpoint, envID)
+	req, err := http.NewRequestWithContext(ctx, "GET", requestURL, nil)
+	if err != nil {
+		return ClusterData{}, err
+	}
+
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return ClusterData{}, err
+	}
+
+	cd := ClusterData{}
+	err = json.Unmarshal(body, &cd)
+	if err != nil {
+		return ClusterData{}, err
+	}
+
+	return cd, nil
+}
+
+// GetCluster - Returns cluster by ID from Altinity.Cloud API.
+func (c *AltinityCloudClient) GetCluster(ctx context.Context, ID string) (Cluster, error) {
+	requestURL := fmt.Sprintf("%s/cluster/%s", c.APIEndpoint, ID)
+	req, err := http.NewRequestWithContext(ctx, "GET", requestURL, nil)
+	if err != nil {
+		return Cluster{}, err
+	}
+
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return Cluster{}, err
+	}
+
+	cr := ClusterResponse{}
+	err = json.Unmarshal(body, &cr)
+	if err != nil {
+		return Cluster{}, err
+	}
+
+	return cr.Data, nil
+}
+
+// CreateCluster - Launches a new ClickHouse cluster in an environment.
+func (c *AltinityCloudClient) CreateCluster(ctx context.Context, envID string, cluster Cluster) (Cluster, error) {
+	// build the POST request, the params go in the body as they carry the admin password
+	requestURL := fmt.Sprintf("%s/environment/%s/clusters", c.APIEndpoint, envID)
+	params := url.Values{}
+	addClusterParams(params, cluster)
+	req, err := newFormRequest(ctx, "POST", requestURL, params)
+	if err != nil {
+		return Cluster{}, err
+	}
+
+	// make the request
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return Cluster{}, err
+	}
+
+	// unmarshal the response
+	cr := ClusterResponse{}
+	err = json.Unmarshal(body, &cr)
+	if err != nil {
+		return Cluster{}, err
+	}
+
+	return cr.Data, nil
+}
+
+// UpdateCluster - Updates an existing ClickHouse cluster by ID.
+func (c *AltinityCloudClient) UpdateCluster(ctx context.Context, cluster Cluster) (Cluster, error) {
+	// build the POST request, the params go in the body as they carry the admin password
+	requestURL := fmt.Sprintf("%s/cluster/%s", c.APIEndpoint, cluster.ID)
+	params := url.Values{}
+	addClusterParams(params, cluster)
+	req, err := newFormRequest(ctx, "POST", requestURL, params)
+	if err != nil {
+		return Cluster{}, err
+	}
+
+	// updating an existing cluster is safe to retry
+	req = retryablePost(req)
+
+	// make the request
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return Cluster{}, err
+	}
+
+	// unmarshal the response
+	cr := ClusterResponse{}
+	err = json.Unmarshal(body, &cr)
+	if err != nil {
+		return Cluster{}, err
+	}
+
+	return cr.Data, nil
+}
+
+// RescaleCluster - Changes the shards, replicas, node type and disk size of a running cluster.
+// Nodes are replaced one at a time, the cluster reports rescaling until it is online again.
+func (c *AltinityCloudClient) RescaleCluster(ctx context.Context, cluster Cluster) (Cluster, error) {
+	// build the POST request
+	requestURL := fmt.Sprintf("%s/cluster/%s/rescale", c.APIEndpoint, cluster.ID)
+	req, err := http.NewRequestWithContext(ctx, "POST", requestURL, nil)
+	if err != nil {
+		return Cluster{}, err
+	}
+
+	// rescaling to the same target size is safe to retry
+	req = retryablePost(req)
+
+	// add the query params
+	q := req.URL.Query()
+	addRescaleParams(q, cluster)
+	req.URL.RawQuery = q.Encode()
+
+	// make the request
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return Cluster{}, err
+	}
+
+	// unmarshal the response
+	cr := ClusterResponse{}
+	err = json.Unmarshal(body, &cr)
+	if err != nil {
+		return Cluster{}, err
+	}
+
+	return cr.Data, nil
+}
+
+// DeleteCluster - Deletes a ClickHouse cluster by ID.
+func (c *AltinityCloudClient) DeleteCluster(ctx context.Context, ID string) error {
+	// build the DELETE request
+	requestURL := fmt.Sprintf("%s/cluster/%s", c.APIEndpoint, ID)
+	req, err := http.NewRequestWithContext(ctx, "DELETE", requestURL, nil)
+	if err != nil {
+		return err
+	}
+
+	// make the request
+	_, err = c.doRequest(req, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// addClusterParams - adds cluster attributes to the request form params.
+func addClusterParams(q url.Values, cluster Cluster) {
+	q.Add("name", cluster.Name)
+	q.Add("version", cluster.Version)
+	q.Add("nodeType", cluster.NodeType)
+	q.Add("shards", strconv.FormatInt(cluster.Shards, 10))
+	q.Add("replicas", strconv.FormatInt(cluster.Replicas, 10))
+	q.Add("size", strconv.FormatInt(cluster.DiskSize, 10))
+
+	// add optional params zookeeper if not null or empty string
+	if len(cluster.Zookeeper) > 0 {
+		q.Add("zookeeper", cluster.Zookeeper)
+	}
+
+	// add optional admin credentials if not null or empty string
+	if len(cluster.AdminUser) > 0 {
+		q.Add("adminUser", cluster.AdminUser)
+	}
+	if len(cluster.AdminPassword) > 0 {
+		q.Add("adminPass", cluster.AdminPassword)
+	}
+}
+
+// addRescaleParams - adds the cluster size attributes to the rescale request query params.
+func addRescaleParams(q url.Values, cluster Cluster) {
+	q.Add("nodeType", cluster.NodeType)
+	q.Add("shards", strconv.FormatInt(cluster.Shards, 10))
+	q.Add("replicas", strconv.FormatInt(cluster.Replicas, 10))
+	q.Add("size", strconv.FormatInt(cluster.DiskSize, 10))
+}
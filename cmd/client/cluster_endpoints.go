@@ -0,0 +1,30 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// GetClusterEndpoints - Returns the hosts, ports and CA certificate apps use to connect to a cluster from Altinity.Cloud API.
+func (c *AltinityCloudClient) GetClusterEndpoints(ctx context.Context, clusterID string) (ClusterEndpoints, error) {
+	requestURL := fmt.Sprintf("%s/cluster/%s/endpoints", c.APIEndpoint, clusterID)
+	req, err := http.NewRequestWithContext(ctx, "GET", requestURL, nil)
+	if err != nil {
+		return ClusterEndpoints{}, err
+	}
+
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return ClusterEndpoints{}, err
+	}
+
+	er := ClusterEndpointsResponse{}
+	err = json.Unmarshal(body, &er)
+	if err != nil {
+		return ClusterEndpoints{}, err
+	}
+
+	return er.Data, nil
+}
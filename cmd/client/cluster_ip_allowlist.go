@@ -0,0 +1,68 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// GetClusterIPAllowlist - Returns the networks allowed to connect to the cluster endpoints from Altinity.Cloud API.
+func (c *AltinityCloudClient) GetClusterIPAllowlist(ctx context.Context, clusterID string) (IPAllowlistData, error) {
+	requestURL := fmt.Sprintf("%s/cluster/%s/allowlist", c.APIEndpoint, clusterID)
+	req, err := http.NewRequestWithContext(ctx, "GET", requestURL, nil)
+	if err != nil {
+		return IPAllowlistData{}, err
+	}
+
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return IPAllowlistData{}, err
+	}
+
+	ad := IPAllowlistData{}
+	err = json.Unmarshal(body, &ad)
+	if err != nil {
+		return IPAllowlistData{}, err
+	}
+
+	return ad, nil
+}
+
+// SetClusterIPAllowlist - Replaces the whole IP allowlist of a cluster. An empty allowlist allows
+// connections from anywhere.
+func (c *AltinityCloudClient) SetClusterIPAllowlist(ctx context.Context, clusterID string, entries []IPAllowlistEntry) (IPAllowlistData, error) {
+	// build the POST request, the entries are sent as a JSON body as long allowlists exceed URL limits
+	if entries == nil {
+		entries = []IPAllowlistEntry{}
+	}
+	encoded, err := json.Marshal(IPAllowlistRequest{Entries: entries})
+	if err != nil {
+		return IPAllowlistData{}, err
+	}
+	requestURL := fmt.Sprintf("%s/cluster/%s/allowlist", c.APIEndpoint, clusterID)
+	req, err := http.NewRequestWithContext(ctx, "POST", requestURL, bytes.NewReader(encoded))
+	if err != nil {
+		return IPAllowlistData{}, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	// replacing the allowlist is safe to retry
+	req = retryablePost(req)
+
+	// make the request
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return IPAllowlistData{}, err
+	}
+
+	// unmarshal the response
+	ad := IPAllowlistData{}
+	err = json.Unmarshal(body, &ad)
+	if err != nil {
+		return IPAllowlistData{}, err
+	}
+
+	return ad, nil
+}
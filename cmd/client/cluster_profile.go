@@ -0,0 +1,153 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// GetClusterProfiles - Returns list of ClickHouse settings profiles of a cluster from Altinity.Cloud API.
+func (c *AltinityCloudClient) GetClusterProfiles(ctx context.Context, clusterID string) (ClusterProfileData, error) {
+	requestURL := fmt.Sprintf("%s/cluster/%s/profiles", c.APIEndpoint, clusterID)
+	req, err := http.NewRequestWithContext(ctx, "GET", requestURL, nil)
+	if err != nil {
+		return ClusterProfileData{}, err
+	}
+
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return ClusterProfileData{}, err
+	}
+
+	pd := ClusterProfileData{}
+	err = json.Unmarshal(body, &pd)
+	if err != nil {
+		return ClusterProfileData{}, err
+	}
+
+	return pd, nil
+}
+
+// GetClusterProfile - Returns ClickHouse settings profile by ID from Altinity.Cloud API.
+func (c *AltinityCloudClient) GetClusterProfile(ctx context.Context, ID string) (ClusterProfile, error) {
+	requestURL := fmt.Sprintf("%s/clusterprofile/%s", c.APIEndpoint, ID)
+	req, err := http.NewRequestWithContext(ctx, "GET", requestURL, nil)
+	if err != nil {
+		return ClusterProfile{}, err
+	}
+
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return ClusterProfile{}, err
+	}
+
+	pr := ClusterProfileResponse{}
+	err = json.Unmarshal(body, &pr)
+	if err != nil {
+		return ClusterProfile{}, err
+	}
+
+	return pr.Data, nil
+}
+
+// CreateClusterProfile - Creates a ClickHouse settings profile in a cluster.
+func (c *AltinityCloudClient) CreateClusterProfile(ctx context.Context, clusterID string, profile ClusterProfile) (ClusterProfile, error) {
+	// build the POST request
+	requestURL := fmt.Sprintf("%s/cluster/%s/profiles", c.APIEndpoint, clusterID)
+	req, err := http.NewRequestWithContext(ctx, "POST", requestURL, nil)
+	if err != nil {
+		return ClusterProfile{}, err
+	}
+
+	// add the query params
+	q := req.URL.Query()
+	addClusterProfileParams(q, profile)
+	req.URL.RawQuery = q.Encode()
+
+	// make the request
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return ClusterProfile{}, err
+	}
+
+	// unmarshal the response
+	pr := ClusterProfileResponse{}
+	err = json.Unmarshal(body, &pr)
+	if err != nil {
+		return ClusterProfile{}, err
+	}
+
+	return pr.Data, nil
+}
+
+// UpdateClusterProfile - Updates a ClickHouse settings profile by ID.
+func (c *AltinityCloudClient) UpdateClusterProfile(ctx context.Context, profile ClusterProfile) (ClusterProfile, error) {
+	// build the POST request
+	requestURL := fmt.Sprintf("%s/clusterprofile/%s", c.APIEndpoint, profile.ID)
+	req, err := http.NewRequestWithContext(ctx, "POST", requestURL, nil)
+	if err != nil {
+		return ClusterProfile{}, err
+	}
+
+	// updating an existing profile is safe to retry
+	req = retryablePost(req)
+
+	// add the query params
+	q := req.URL.Query()
+	addClusterProfileParams(q, profile)
+	req.URL.RawQuery = q.Encode()
+
+	// make the request
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return ClusterProfile{}, err
+	}
+
+	// unmarshal the response
+	pr := ClusterProfileResponse{}
+	err = json.Unmarshal(body, &pr)
+	if err != nil {
+		return ClusterProfile{}, err
+	}
+
+	return pr.Data, nil
+}
+
+// DeleteClusterProfile - Deletes a ClickHouse settings profile by ID.
+func (c *AltinityCloudClient) DeleteClusterProfile(ctx context.Context, ID string) error {
+	// build the DELETE request
+	requestURL := fmt.Sprintf("%s/clusterprofile/%s", c.APIEndpoint, ID)
+	req, err := http.NewRequestWithContext(ctx, "DELETE", requestURL, nil)
+	if err != nil {
+		return err
+	}
+
+	// make the request
+	_, err = c.doRequest(req, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// addClusterProfileParams - adds cluster profile attributes to the request query params.
+// The settings are sent as a JSON encoded object, e.g. {"readonly":"1"}.
+func addClusterProfileParams(q url.Values, profile ClusterProfile) {
+	q.Add("name", profile.Name)
+
+	// add optional params description if not null or empty string
+	if len(profile.Description) > 0 {
+		q.Add("description", profile.Description)
+	}
+
+	// map keys are always marshaled sorted
+	settings := profile.Settings
+	if settings == nil {
+		settings = map[string]string{}
+	}
+	b, _ := json.Marshal(settings)
+	q.Add("settings", string(b))
+}
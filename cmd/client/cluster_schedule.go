@@ -0,0 +1,105 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// Cluster uptime schedule modes supported by Altinity.Cloud API.
+const (
+	ScheduleModeAlwaysOn         = "always_on"
+	ScheduleModeStopWhenInactive = "stop_when_inactive"
+	ScheduleModeWeekly           = "weekly"
+)
+
+// GetClusterSchedule - Returns the uptime schedule of a cluster from Altinity.Cloud API.
+func (c *AltinityCloudClient) GetClusterSchedule(ctx context.Context, clusterID string) (ClusterSchedule, error) {
+	requestURL := fmt.Sprintf("%s/cluster/%s/schedule", c.APIEndpoint, clusterID)
+	req, err := http.NewRequestWithContext(ctx, "GET", requestURL, nil)
+	if err != nil {
+		return ClusterSchedule{}, err
+	}
+
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return ClusterSchedule{}, err
+	}
+
+	sr := ClusterScheduleResponse{}
+	err = json.Unmarshal(body, &sr)
+	if err != nil {
+		return ClusterSchedule{}, err
+	}
+
+	return sr.Data, nil
+}
+
+// SetClusterSchedule - Creates or replaces the uptime schedule of a cluster.
+func (c *AltinityCloudClient) SetClusterSchedule(ctx context.Context, schedule ClusterSchedule) (ClusterSchedule, error) {
+	// build the POST request
+	requestURL := fmt.Sprintf("%s/cluster/%s/schedule", c.APIEndpoint, schedule.ClusterID)
+	req, err := http.NewRequestWithContext(ctx, "POST", requestURL, nil)
+	if err != nil {
+		return ClusterSchedule{}, err
+	}
+
+	// replacing the schedule is safe to retry
+	req = retryablePost(req)
+
+	// add the query params
+	q := req.URL.Query()
+	addClusterScheduleParams(q, schedule)
+	req.URL.RawQuery = q.Encode()
+
+	// make the request
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return ClusterSchedule{}, err
+	}
+
+	// unmarshal the response
+	sr := ClusterScheduleResponse{}
+	err = json.Unmarshal(body, &sr)
+	if err != nil {
+		return ClusterSchedule{}, err
+	}
+
+	return sr.Data, nil
+}
+
+// DeleteClusterSchedule - Removes the uptime schedule of a cluster, which keeps the cluster always on.
+func (c *AltinityCloudClient) DeleteClusterSchedule(ctx context.Context, clusterID string) error {
+	// build the DELETE request
+	requestURL := fmt.Sprintf("%s/cluster/%s/schedule", c.APIEndpoint, clusterID)
+	req, err := http.NewRequestWithContext(ctx, "DELETE", requestURL, nil)
+	if err != nil {
+		return err
+	}
+
+	// make the request
+	_, err = c.doRequest(req, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// addClusterScheduleParams - adds cluster schedule attributes to the request query params.
+func addClusterScheduleParams(q url.Values, schedule ClusterSchedule) {
+	q.Add("mode", schedule.Mode)
+
+	switch schedule.Mode {
+	case ScheduleModeStopWhenInactive:
+		q.Add("inactiveHours", strconv.FormatInt(schedule.InactiveHours, 10))
+	case ScheduleModeWeekly:
+		q.Add("timezone", schedule.Timezone)
+		// map keys are always marshaled sorted
+		days, _ := json.Marshal(schedule.Days)
+		q.Add("days", string(days))
+	}
+}
@@ -0,0 +1,173 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// GetClusterSettings - Returns list of server settings of a cluster from Altinity.Cloud API.
+func (c *AltinityCloudClient) GetClusterSettings(ctx context.Context, clusterID string) (ClusterSettingData, error) {
+	requestURL := fmt.Sprintf("%s/cluster/%s/settings", c.APIEndpoint, clusterID)
+	req, err := http.NewRequestWithContext(ctx, "GET", requestURL, nil)
+	if err != nil {
+		return ClusterSettingData{}, err
+	}
+
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return ClusterSettingData{}, err
+	}
+
+	sd := ClusterSettingData{}
+	err = json.Unmarshal(body, &sd)
+	if err != nil {
+		return ClusterSettingData{}, err
+	}
+
+	return sd, nil
+}
+
+// GetClusterSetting - Returns server setting by ID from Altinity.Cloud API.
+func (c *AltinityCloudClient) GetClusterSetting(ctx context.Context, ID string) (ClusterSetting, error) {
+	requestURL := fmt.Sprintf("%s/clustersetting/%s", c.APIEndpoint, ID)
+	req, err := http.NewRequestWithContext(ctx, "GET", requestURL, nil)
+	if err != nil {
+		return ClusterSetting{}, err
+	}
+
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return ClusterSetting{}, err
+	}
+
+	sr := ClusterSettingResponse{}
+	err = json.Unmarshal(body, &sr)
+	if err != nil {
+		return ClusterSetting{}, err
+	}
+
+	return sr.Data, nil
+}
+
+// CreateClusterSetting - Creates a server setting in a cluster.
+func (c *AltinityCloudClient) CreateClusterSetting(ctx context.Context, clusterID string, setting ClusterSetting) (ClusterSetting, error) {
+	// build the POST request
+	requestURL := fmt.Sprintf("%s/cluster/%s/settings", c.APIEndpoint, clusterID)
+	req, err := http.NewRequestWithContext(ctx, "POST", requestURL, nil)
+	if err != nil {
+		return ClusterSetting{}, err
+	}
+
+	// add the query params
+	q := req.URL.Query()
+	addClusterSettingParams(q, setting)
+	req.URL.RawQuery = q.Encode()
+
+	// make the request
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return ClusterSetting{}, err
+	}
+
+	// unmarshal the response
+	sr := ClusterSettingResponse{}
+	err = json.Unmarshal(body, &sr)
+	if err != nil {
+		return ClusterSetting{}, err
+	}
+
+	return sr.Data, nil
+}
+
+// UpdateClusterSetting - Updates a server setting by ID.
+func (c *AltinityCloudClient) UpdateClusterSetting(ctx context.Context, setting ClusterSetting) (ClusterSetting, error) {
+	// build the POST request
+	requestURL := fmt.Sprintf("%s/clustersetting/%s", c.APIEndpoint, setting.ID)
+	req, err := http.NewRequestWithContext(ctx, "POST", requestURL, nil)
+	if err != nil {
+		return ClusterSetting{}, err
+	}
+
+	// updating an existing setting is safe to retry
+	req = retryablePost(req)
+
+	// add the query params
+	q := req.URL.Query()
+	addClusterSettingParams(q, setting)
+	req.URL.RawQuery = q.Encode()
+
+	// make the request
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return ClusterSetting{}, err
+	}
+
+	// unmarshal the response
+	sr := ClusterSettingResponse{}
+	err = json.Unmarshal(body, &sr)
+	if err != nil {
+		return ClusterSetting{}, err
+	}
+
+	return sr.Data, nil
+}
+
+// DeleteClusterSetting - Deletes a server setting by ID.
+func (c *AltinityCloudClient) DeleteClusterSetting(ctx context.Context, ID string) error {
+	// build the DELETE request
+	requestURL := fmt.Sprintf("%s/clustersetting/%s", c.APIEndpoint, ID)
+	req, err := http.NewRequestWithContext(ctx, "DELETE", requestURL, nil)
+	if err != nil {
+		return err
+	}
+
+	// make the request
+	_, err = c.doRequest(req, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// RestartCluster - Starts a rolling restart of a ClickHouse cluster by ID.
+func (c *AltinityCloudClient) RestartCluster(ctx context.Context, ID string) (Cluster, error) {
+	// build the POST request
+	requestURL := fmt.Sprintf("%s/cluster/%s/restart", c.APIEndpoint, ID)
+	req, err := http.NewRequestWithContext(ctx, "POST", requestURL, nil)
+	if err != nil {
+		return Cluster{}, err
+	}
+
+	// make the request
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return Cluster{}, err
+	}
+
+	// unmarshal the response
+	cr := ClusterResponse{}
+	err = json.Unmarshal(body, &cr)
+	if err != nil {
+		return Cluster{}, err
+	}
+
+	return cr.Data, nil
+}
+
+// addClusterSettingParams - adds cluster setting attributes to the request query params.
+func addClusterSettingParams(q url.Values, setting ClusterSetting) {
+	q.Add("name", setting.Name)
+	q.Add("restartRequired", strconv.FormatBool(setting.RestartRequired))
+
+	// a setting holds either an attribute value or a file content
+	if len(setting.FileContent) > 0 {
+		q.Add("file", setting.FileContent)
+	} else {
+		q.Add("value", setting.Value)
+	}
+}
@@ -0,0 +1,152 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// GetClusterUsers - Returns list of ClickHouse users of a cluster from Altinity.Cloud API.
+func (c *AltinityCloudClient) GetClusterUsers(ctx context.Context, clusterID string) (ClusterUserData, error) {
+	requestURL := fmt.Sprintf("%s/cluster/%s/users", c.APIEndpoint, clusterID)
+	req, err := http.NewRequestWithContext(ctx, "GET", requestURL, nil)
+	if err != nil {
+		return ClusterUserData{}, err
+	}
+
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return ClusterUserData{}, err
+	}
+
+	ud := ClusterUserData{}
+	err = json.Unmarshal(body, &ud)
+	if err != nil {
+		return ClusterUserData{}, err
+	}
+
+	return ud, nil
+}
+
+// GetClusterUser - Returns ClickHouse user by ID from Altinity.Cloud API. The password is never returned.
+func (c *AltinityCloudClient) GetClusterUser(ctx context.Context, ID string) (ClusterUser, error) {
+	requestURL := fmt.Sprintf("%s/clusteruser/%s", c.APIEndpoint, ID)
+	req, err := http.NewRequestWithContext(ctx, "GET", requestURL, nil)
+	if err != nil {
+		return ClusterUser{}, err
+	}
+
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return ClusterUser{}, err
+	}
+
+	ur := ClusterUserResponse{}
+	err = json.Unmarshal(body, &ur)
+	if err != nil {
+		return ClusterUser{}, err
+	}
+
+	return ur.Data, nil
+}
+
+// CreateClusterUser - Creates a ClickHouse user in a cluster.
+func (c *AltinityCloudClient) CreateClusterUser(ctx context.Context, clusterID string, user ClusterUser) (ClusterUser, error) {
+	// build the POST request, the params go in the body as they carry the password
+	requestURL := fmt.Sprintf("%s/cluster/%s/users", c.APIEndpoint, clusterID)
+	params := url.Values{}
+	addClusterUserParams(params, user)
+	req, err := newFormRequest(ctx, "POST", requestURL, params)
+	if err != nil {
+		return ClusterUser{}, err
+	}
+
+	// make the request
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return ClusterUser{}, err
+	}
+
+	// unmarshal the response
+	ur := ClusterUserResponse{}
+	err = json.Unmarshal(body, &ur)
+	if err != nil {
+		return ClusterUser{}, err
+	}
+
+	return ur.Data, nil
+}
+
+// UpdateClusterUser - Updates a ClickHouse user by ID. The password is only changed when set.
+func (c *AltinityCloudClient) UpdateClusterUser(ctx context.Context, user ClusterUser) (ClusterUser, error) {
+	// build the POST request, the params go in the body as they carry the password
+	requestURL := fmt.Sprintf("%s/clusteruser/%s", c.APIEndpoint, user.ID)
+	params := url.Values{}
+	addClusterUserParams(params, user)
+	req, err := newFormRequest(ctx, "POST", requestURL, params)
+	if err != nil {
+		return ClusterUser{}, err
+	}
+
+	// updating an existing user is safe to retry
+	req = retryablePost(req)
+
+	// make the request
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return ClusterUser{}, err
+	}
+
+	// unmarshal the response
+	ur := ClusterUserResponse{}
+	err = json.Unmarshal(body, &ur)
+	if err != nil {
+		return ClusterUser{}, err
+	}
+
+	return ur.Data, nil
+}
+
+// DeleteClusterUser - Deletes a ClickHouse user by ID.
+func (c *AltinityCloudClient) DeleteClusterUser(ctx context.Context, ID string) error {
+	// build the DELETE request
+	requestURL := fmt.Sprintf("%s/clusteruser/%s", c.APIEndpoint, ID)
+	req, err := http.NewRequestWithContext(ctx, "DELETE", requestURL, nil)
+	if err != nil {
+		return err
+	}
+
+	// make the request
+	_, err = c.doRequest(req, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// addClusterUserParams - adds cluster user attributes to the request form params.
+func addClusterUserParams(q url.Values, user ClusterUser) {
+	q.Add("name", user.Name)
+
+	// add optional params if not null or empty
+	if len(user.Password) > 0 {
+		q.Add("password", user.Password)
+	}
+	if len(user.Profile) > 0 {
+		q.Add("profile", user.Profile)
+	}
+	if len(user.Quota) > 0 {
+		q.Add("quota", user.Quota)
+	}
+	if len(user.Networks) > 0 {
+		q.Add("networks", strings.Join(user.Networks, ","))
+	}
+	// an empty list grants all databases, nil keeps the current ones
+	if user.Databases != nil {
+		q.Add("databases", strings.Join(user.Databases, ","))
+	}
+}
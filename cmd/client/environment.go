@@ -0,0 +1,169 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// GetEnvironments - Returns list of environments available to the API token from Altinity.Cloud API.
+func (c *AltinityCloudClient) GetEnvironments(ctx context.Context) (EnvironmentData, error) {
+	requestURL := fmt.Sprintf("%s/environments", c.APIEndpoint)
+	req, err := http.NewRequestWithContext(ctx, "GET", requestURL, nil)
+	if err != nil {
+		return EnvironmentData{}, err
+	}
+
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return EnvironmentData{}, err
+	}
+
+	ed := EnvironmentData{}
+	err = json.Unmarshal(body, &ed)
+	if err != nil {
+		return EnvironmentData{}, err
+	}
+
+	return ed, nil
+}
+
+// GetEnvironment - Returns environment by ID from Altinity.Cloud API.
+func (c *AltinityCloudClient) GetEnvironment(ctx context.Context, ID string) (Environment, error) {
+	requestURL := fmt.Sprintf("%s/environment/%s", c.APIEndpoint, ID)
+	req, err := http.NewRequestWithContext(ctx, "GET", requestURL, nil)
+	if err != nil {
+		return Environment{}, err
+	}
+
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return Environment{}, err
+	}
+
+	er := EnvironmentResponse{}
+	err = json.Unmarshal(body, &er)
+	if err != nil {
+		return Environment{}, err
+	}
+
+	return er.Data, nil
+}
+
+// GetEnvironmentByName - Returns environment by name from Altinity.Cloud API.
+func (c *AltinityCloudClient) GetEnvironmentByName(ctx context.Context, name string) (Environment, error) {
+	ed, err := c.GetEnvironments(ctx)
+	if err != nil {
+		return Environment{}, fmt.Errorf("client: could not list environments: %w", err)
+	}
+
+	for _, env := range ed.Environments {
+		if env.Name == name {
+			return env, nil
+		}
+	}
+
+	return Environment{}, fmt.Errorf("client: environment %s: %w", name, ErrNotFound)
+}
+
+// CreateEnvironment - Creates a new Altinity.Cloud environment.
+func (c *AltinityCloudClient) CreateEnvironment(ctx context.Context, env Environment) (Environment, error) {
+	// build the POST request
+	requestURL := fmt.Sprintf("%s/environments", c.APIEndpoint)
+	req, err := http.NewRequestWithContext(ctx, "POST", requestURL, nil)
+	if err != nil {
+		return Environment{}, err
+	}
+
+	// add the query params
+	q := req.URL.Query()
+	q.Add("cloud", env.Cloud)
+	q.Add("region", env.Region)
+	addEnvironmentParams(q, env)
+	req.URL.RawQuery = q.Encode()
+
+	// make the request
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return Environment{}, err
+	}
+
+	// unmarshal the response
+	er := EnvironmentResponse{}
+	err = json.Unmarshal(body, &er)
+	if err != nil {
+		return Environment{}, err
+	}
+
+	return er.Data, nil
+}
+
+// UpdateEnvironment - Updates an existing Altinity.Cloud environment by ID.
+func (c *AltinityCloudClient) UpdateEnvironment(ctx context.Context, env Environment) (Environment, error) {
+	// build the POST request
+	requestURL := fmt.Sprintf("%s/environment/%s", c.APIEndpoint, env.ID)
+	req, err := http.NewRequestWithContext(ctx, "POST", requestURL, nil)
+	if err != nil {
+		return Environment{}, err
+	}
+
+	// updating an existing environment is safe to retry
+	req = retryablePost(req)
+
+	// add the query params
+	q := req.URL.Query()
+	addEnvironmentParams(q, env)
+	req.URL.RawQuery = q.Encode()
+
+	// make the request
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return Environment{}, err
+	}
+
+	// unmarshal the response
+	er := EnvironmentResponse{}
+	err = json.Unmarshal(body, &er)
+	if err != nil {
+		return Environment{}, err
+	}
+
+	return er.Data, nil
+}
+
+// DeleteEnvironment - Deletes an Altinity.Cloud environment by ID.
+func (c *AltinityCloudClient) DeleteEnvironment(ctx context.Context, ID string) error {
+	// build the DELETE request
+	requestURL := fmt.Sprintf("%s/environment/%s", c.APIEndpoint, ID)
+	req, err := http.NewRequestWithContext(ctx, "DELETE", requestURL, nil)
+	if err != nil {
+		return err
+	}
+
+	// make the request
+	_, err = c.doRequest(req, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// addEnvironmentParams - adds the updatable environment attributes to the request query params.
+func addEnvironmentParams(q url.Values, env Environment) {
+	q.Add("name", env.Name)
+
+	// add optional params if not null or empty
+	if len(env.Zones) > 0 {
+		q.Add("zones", strings.Join(env.Zones, ","))
+	}
+	if len(env.DefaultClickHouseNodeType) > 0 {
+		q.Add("nodeTypeClickHouse", env.DefaultClickHouseNodeType)
+	}
+	if len(env.DefaultZookeeperNodeType) > 0 {
+		q.Add("nodeTypeZookeeper", env.DefaultZookeeperNodeType)
+	}
+}
@@ -0,0 +1,132 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// RequestIDHeader - response header carrying the Altinity.Cloud API request ID.
+const RequestIDHeader = "X-Request-Id"
+
+// ErrNotFound - returned when the requested object does not exist in Altinity.Cloud.
+var ErrNotFound = errors.New("not found")
+
+// APIError - error response returned by Altinity.Cloud API.
+type APIError struct {
+	StatusCode int
+	Code       string
+	Message    string
+	RequestID  string
+	Fields     []FieldError
+	// Body - raw response body, kept when the error can not be parsed.
+	Body string
+}
+
+// FieldError - validation error of a single request field.
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// apiErrorBody - Altinity.Cloud API error payload, either at the top level
+// of the response or nested in an "error" object.
+type apiErrorBody struct {
+	Code    string       `json:"code"`
+	Message string       `json:"message"`
+	Fields  []FieldError `json:"fields"`
+}
+
+// Error - implements the error interface.
+func (e *APIError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "status: %d", e.StatusCode)
+	if e.Code != "" {
+		fmt.Fprintf(&b, ", code: %s", e.Code)
+	}
+	if e.Message != "" {
+		fmt.Fprintf(&b, ", message: %s", e.Message)
+	} else if e.Body != "" {
+		fmt.Fprintf(&b, ", body: %s", e.Body)
+	}
+	for _, f := range e.Fields {
+		fmt.Fprintf(&b, ", %s: %s", f.Field, f.Message)
+	}
+	if e.RequestID != "" {
+		fmt.Fprintf(&b, ", request id: %s", e.RequestID)
+	}
+	return b.String()
+}
+
+// newAPIError - builds APIError from a non successful response.
+func newAPIError(res *http.Response, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: res.StatusCode,
+		RequestID:  res.Header.Get(RequestIDHeader),
+	}
+
+	var payload struct {
+		apiErrorBody
+		Error json.RawMessage `json:"error"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		apiErr.Body = string(body)
+		return apiErr
+	}
+
+	eb := payload.apiErrorBody
+	if len(payload.Error) > 0 {
+		// the error is either a plain message or a nested error object
+		var msg string
+		if err := json.Unmarshal(payload.Error, &msg); err == nil {
+			eb.Message = msg
+		} else {
+			_ = json.Unmarshal(payload.Error, &eb)
+		}
+	}
+
+	apiErr.Code = eb.Code
+	apiErr.Message = eb.Message
+	apiErr.Fields = eb.Fields
+	if apiErr.Message == "" && len(apiErr.Fields) == 0 {
+		apiErr.Body = string(body)
+	}
+
+	return apiErr
+}
+
+// hasStatus - returns true if err is an APIError with one of the given status codes.
+func hasStatus(err error, codes ...int) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	for _, code := range codes {
+		if apiErr.StatusCode == code {
+			return true
+		}
+	}
+	return false
+}
+
+// IsNotFound - returns true if the requested object does not exist.
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound) || hasStatus(err, http.StatusNotFound)
+}
+
+// IsConflict - returns true if the request conflicts with the current state of the object.
+func IsConflict(err error) bool {
+	return hasStatus(err, http.StatusConflict)
+}
+
+// IsUnauthorized - returns true if the API token is missing, invalid or lacks permissions.
+func IsUnauthorized(err error) bool {
+	return hasStatus(err, http.StatusUnauthorized, http.StatusForbidden)
+}
+
+// IsValidation - returns true if the request was rejected because of invalid fields.
+func IsValidation(err error) bool {
+	return hasStatus(err, http.StatusBadRequest, http.StatusUnprocessableEntity)
+}
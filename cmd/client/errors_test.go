@@ -0,0 +1,62 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+func TestAPIErrorParsing(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		body    string
+		code    string
+		message string
+		fields  int
+	}{
+		{"nested", http.StatusUnprocessableEntity, `{"error":{"code":"validation_failed","message":"invalid node type","fields":[{"field":"storageClass","message":"unknown storage class"}]}}`, "validation_failed", "invalid node type", 1},
+		{"flat", http.StatusConflict, `{"code":"conflict","message":"node type already exists"}`, "conflict", "node type already exists", 0},
+		{"plain", http.StatusForbidden, `{"error":"access denied"}`, "", "access denied", 0},
+		{"not json", http.StatusBadGateway, `<html>bad gateway</html>`, "", "", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set(RequestIDHeader, "req-1")
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			})
+			c.MaxRetries = 0
+
+			_, err := c.GetNodeTypes(context.Background(), "1")
+			apiErr, ok := err.(*APIError)
+			if !ok {
+				t.Fatalf("GetNodeTypes() error, want *APIError got %T", err)
+			}
+			assert.Equal(t, tt.status, apiErr.StatusCode)
+			assert.Equal(t, tt.code, apiErr.Code)
+			assert.Equal(t, tt.message, apiErr.Message)
+			assert.Equal(t, "req-1", apiErr.RequestID)
+			assert.Len(t, apiErr.Fields, tt.fields)
+			if tt.message == "" {
+				assert.Equal(t, tt.body, apiErr.Body)
+			}
+		})
+	}
+}
+
+func TestAPIErrorHelpers(t *testing.T) {
+	notFound := fmt.Errorf("wrapped: %w", &APIError{StatusCode: http.StatusNotFound})
+	assert.True(t, IsNotFound(notFound))
+	assert.False(t, IsConflict(notFound))
+
+	assert.True(t, IsConflict(&APIError{StatusCode: http.StatusConflict}))
+	assert.True(t, IsUnauthorized(&APIError{StatusCode: http.StatusUnauthorized}))
+	assert.True(t, IsUnauthorized(&APIError{StatusCode: http.StatusForbidden}))
+	assert.True(t, IsValidation(&APIError{StatusCode: http.StatusBadRequest}))
+	assert.False(t, IsNotFound(fmt.Errorf("status: 404")))
+}
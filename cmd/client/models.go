@@ -1,5 +1,10 @@
 package client
 
+import (
+	"bytes"
+	"encoding/json"
+)
+
 // NodeTypeData - list of NodeType types.
 type NodeTypeData struct {
 	NodeTypes []NodeType `json:"data"`
@@ -15,13 +20,79 @@ type NodeType struct {
 	StorageClass string       `json:"storageClass"`
 	CPU          string       `json:"cpu"`
 	Memory       string       `json:"memory"`
-	ExtraSpec    string       `json:"extraSpec,omitempty"`
+	ExtraSpec    JSONString   `json:"extraSpec,omitempty"`
 	Tolerations  []Toleration `json:"tolerations,omitempty"`
-	NodeSelector string       `json:"nodeSelector,omitempty"`
+	NodeSelector NodeSelector `json:"nodeSelector,omitempty"`
 	CPUAlloc     string       `json:"cpu_alloc,omitempty"`
 	MemoryAlloc  string       `json:"memory_alloc,omitempty"`
 }
 
+// NodeSelector - Kubernetes node selector labels. The API stores it as a JSON encoded string.
+type NodeSelector map[string]string
+
+// String - returns the node selector in the API format, e.g. {"disktype":"ssd"}.
+func (ns NodeSelector) String() string {
+	if len(ns) == 0 {
+		return ""
+	}
+	// map keys are always marshaled sorted
+	b, _ := json.Marshal(map[string]string(ns))
+	return string(b)
+}
+
+// MarshalJSON - encodes the node selector as a JSON string.
+func (ns NodeSelector) MarshalJSON() ([]byte, error) {
+	return json.Marshal(ns.String())
+}
+
+// UnmarshalJSON - decodes the node selector from a JSON encoded string or a plain object.
+func (ns *NodeSelector) UnmarshalJSON(b []byte) error {
+	raw, err := unquoteJSON(b)
+	if err != nil || len(raw) == 0 {
+		*ns = nil
+		return err
+	}
+
+	m := map[string]string{}
+	if err := json.Unmarshal(raw, &m); err != nil {
+		return err
+	}
+	*ns = m
+	return nil
+}
+
+// JSONString - free form JSON document the API stores as a JSON encoded string.
+type JSONString string
+
+// UnmarshalJSON - decodes the document from a JSON encoded string or a plain JSON value.
+func (js *JSONString) UnmarshalJSON(b []byte) error {
+	raw, err := unquoteJSON(b)
+	if err != nil {
+		return err
+	}
+	*js = JSONString(raw)
+	return nil
+}
+
+// unquoteJSON - returns the JSON document held by a JSON string, or the value itself if it is not a string.
+// Empty strings, null and empty objects are returned as empty.
+func unquoteJSON(b []byte) ([]byte, error) {
+	b = bytes.TrimSpace(b)
+	if len(b) > 0 && b[0] == '"' {
+		var s string
+		if err := json.Unmarshal(b, &s); err != nil {
+			return nil, err
+		}
+		b = bytes.TrimSpace([]byte(s))
+	}
+
+	switch string(b) {
+	case "", "null", "{}":
+		return nil, nil
+	}
+	return b, nil
+}
+
 // Toleration - node type toleration model.
 type Toleration struct {
 	Key      string `json:"key"`
@@ -30,10 +101,326 @@ type Toleration struct {
 	Effect   string `json:"effect"`
 }
 
-// NodeTypeCreateResponse - response from create node type.
+// NodeTypeCreateResponse - response from create, update and get node type.
 type NodeTypeCreateResponse struct {
 	Metadata struct {
 		Changed bool `json:"changed"`
 	} `json:"metadata"`
 	Data NodeType `json:"data"`
 }
+
+// ClusterData - list of Cluster types.
+type ClusterData struct {
+	Clusters []Cluster `json:"data"`
+}
+
+// Cluster - ClickHouse cluster model.
+type Cluster struct {
+	ID            string `json:"id"`
+	EnvID         string `json:"environment"`
+	Name          string `json:"name"`
+	Version       string `json:"version"`
+	NodeType      string `json:"nodeType"`
+	Shards        int64  `json:"shards"`
+	Replicas      int64  `json:"replicas"`
+	DiskSize      int64  `json:"size"`
+	Zookeeper     string `json:"zookeeper"`
+	AdminUser     string `json:"adminUser"`
+	AdminPassword string `json:"adminPass,omitempty"`
+	Status        string `json:"status,omitempty"`
+}
+
+// ClusterResponse - response from create, update and get cluster.
+type ClusterResponse struct {
+	Metadata struct {
+		Changed bool `json:"changed"`
+	} `json:"metadata"`
+	Data Cluster `json:"data"`
+}
+
+// ClusterEndpoints - hosts, ports and CA certificate apps use to connect to a cluster.
+type ClusterEndpoints struct {
+	ClusterID string `json:"cluster"`
+	// Host - load balanced host apps connect to, the private endpoint host when the environment has one.
+	Host          string            `json:"host"`
+	DNSName       string            `json:"dnsName"`
+	HTTPPort      int64             `json:"httpPort"`
+	HTTPSPort     int64             `json:"httpsPort"`
+	NativePort    int64             `json:"nativePort"`
+	NativeTLSPort int64             `json:"nativeTlsPort"`
+	Replicas      []ReplicaEndpoint `json:"replicas"`
+	CACertificate string            `json:"caCertificate"`
+}
+
+// ReplicaEndpoint - host of a single replica, used to reach a replica directly instead of through the load balancer.
+type ReplicaEndpoint struct {
+	Shard   int64  `json:"shard"`
+	Replica int64  `json:"replica"`
+	Host    string `json:"host"`
+}
+
+// ClusterEndpointsResponse - response from get cluster endpoints.
+type ClusterEndpointsResponse struct {
+	Metadata struct {
+		Changed bool `json:"changed"`
+	} `json:"metadata"`
+	Data ClusterEndpoints `json:"data"`
+}
+
+// EnvironmentData - list of Environment types.
+type EnvironmentData struct {
+	Environments []Environment `json:"data"`
+}
+
+// Environment - Altinity.Cloud environment model.
+type Environment struct {
+	ID                        string            `json:"id"`
+	Name                      string            `json:"name"`
+	Cloud                     string            `json:"cloud"`
+	Region                    string            `json:"region"`
+	Zones                     []string          `json:"zones"`
+	DefaultClickHouseNodeType string            `json:"nodeTypeClickHouse"`
+	DefaultZookeeperNodeType  string            `json:"nodeTypeZookeeper"`
+	KubernetesVersion         string            `json:"k8sVersion,omitempty"`
+	KubernetesNamespace       string            `json:"k8sNamespace,omitempty"`
+	Limits                    EnvironmentLimits `json:"limits"`
+}
+
+// EnvironmentLimits - resource limits of an Altinity.Cloud environment.
+type EnvironmentLimits struct {
+	Clusters int64 `json:"clusters"`
+	Shards   int64 `json:"shards"`
+	Replicas int64 `json:"replicas"`
+	Nodes    int64 `json:"nodes"`
+	DiskSize int64 `json:"size"`
+}
+
+// EnvironmentResponse - response from create, update and get environment.
+type EnvironmentResponse struct {
+	Metadata struct {
+		Changed bool `json:"changed"`
+	} `json:"metadata"`
+	Data Environment `json:"data"`
+}
+
+// ClusterUserData - list of ClusterUser types.
+type ClusterUserData struct {
+	Users []ClusterUser `json:"data"`
+}
+
+// ClusterUser - ClickHouse user of a cluster.
+type ClusterUser struct {
+	ID        string   `json:"id"`
+	ClusterID string   `json:"cluster"`
+	Name      string   `json:"name"`
+	Password  string   `json:"password,omitempty"`
+	Profile   string   `json:"profile"`
+	Quota     string   `json:"quota"`
+	Networks  []string `json:"networks"`
+	Databases []string `json:"databases"`
+}
+
+// ClusterUserResponse - response from create, update and get cluster user.
+type ClusterUserResponse struct {
+	Metadata struct {
+		Changed bool `json:"changed"`
+	} `json:"metadata"`
+	Data ClusterUser `json:"data"`
+}
+
+// ClusterSettingData - list of ClusterSetting types.
+type ClusterSettingData struct {
+	Settings []ClusterSetting `json:"data"`
+}
+
+// ClusterSetting - ClickHouse server setting of a cluster, either an attribute
+// value such as `max_concurrent_queries` or the content of a config file.
+type ClusterSetting struct {
+	ID              string `json:"id"`
+	ClusterID       string `json:"cluster"`
+	Name            string `json:"name"`
+	Value           string `json:"value,omitempty"`
+	FileContent     string `json:"file,omitempty"`
+	RestartRequired bool   `json:"restartRequired"`
+}
+
+// ClusterSettingResponse - response from create, update and get cluster setting.
+type ClusterSettingResponse struct {
+	Metadata struct {
+		Changed bool `json:"changed"`
+	} `json:"metadata"`
+	Data ClusterSetting `json:"data"`
+}
+
+// ClusterProfileData - list of ClusterProfile types.
+type ClusterProfileData struct {
+	Profiles []ClusterProfile `json:"data"`
+}
+
+// ClusterProfile - ClickHouse settings profile of a cluster, e.g. limits like `max_memory_usage`
+// that cluster users refer to by name.
+type ClusterProfile struct {
+	ID          string            `json:"id"`
+	ClusterID   string            `json:"cluster"`
+	Name        string            `json:"name"`
+	Description string            `json:"description,omitempty"`
+	Settings    map[string]string `json:"settings"`
+}
+
+// ClusterProfileResponse - response from create, update and get cluster profile.
+type ClusterProfileResponse struct {
+	Metadata struct {
+		Changed bool `json:"changed"`
+	} `json:"metadata"`
+	Data ClusterProfile `json:"data"`
+}
+
+// BackupSchedule - scheduled backup configuration of a cluster.
+type BackupSchedule struct {
+	ClusterID   string `json:"cluster"`
+	Schedule    string `json:"schedule"`
+	Retention   int64  `json:"retention"`
+	Bucket      string `json:"bucket"`
+	Compression string `json:"compression"`
+}
+
+// BackupScheduleResponse - response from set and get backup schedule.
+type BackupScheduleResponse struct {
+	Metadata struct {
+		Changed bool `json:"changed"`
+	} `json:"metadata"`
+	Data BackupSchedule `json:"data"`
+}
+
+// BackupData - list of Backup types.
+type BackupData struct {
+	Backups []Backup `json:"data"`
+}
+
+// Backup - backup of a cluster in the backup storage.
+type Backup struct {
+	ID        string `json:"id"`
+	ClusterID string `json:"cluster"`
+	Name      string `json:"name"`
+	Status    string `json:"status"`
+	Size      int64  `json:"size"`
+	CreatedAt string `json:"created"`
+}
+
+// BackupResponse - response from create and get backup.
+type BackupResponse struct {
+	Metadata struct {
+		Changed bool `json:"changed"`
+	} `json:"metadata"`
+	Data Backup `json:"data"`
+}
+
+// Restore - operation restoring a backup into a cluster.
+type Restore struct {
+	ID        string `json:"id"`
+	ClusterID string `json:"cluster"`
+	BackupID  string `json:"backup"`
+	Status    string `json:"status"`
+	Progress  int64  `json:"progress"`
+	Error     string `json:"error,omitempty"`
+}
+
+// RestoreResponse - response from start and get restore.
+type RestoreResponse struct {
+	Metadata struct {
+		Changed bool `json:"changed"`
+	} `json:"metadata"`
+	Data Restore `json:"data"`
+}
+
+// ClusterSchedule - uptime schedule of a cluster. Depending on the mode the cluster is always on,
+// stopped after a number of inactive hours, or runs on the given days of the week.
+type ClusterSchedule struct {
+	ClusterID     string                 `json:"cluster"`
+	Mode          string                 `json:"mode"`
+	InactiveHours int64                  `json:"inactiveHours,omitempty"`
+	Timezone      string                 `json:"timezone,omitempty"`
+	Days          map[string]ScheduleDay `json:"days,omitempty"`
+}
+
+// ScheduleDay - time of day a cluster is started and stopped, e.g. `08:00` and `20:00`.
+type ScheduleDay struct {
+	Start string `json:"start"`
+	Stop  string `json:"stop"`
+}
+
+// ClusterScheduleResponse - response from set and get cluster schedule.
+type ClusterScheduleResponse struct {
+	Metadata struct {
+		Changed bool `json:"changed"`
+	} `json:"metadata"`
+	Data ClusterSchedule `json:"data"`
+}
+
+// ClickHouseVersion - ClickHouse build available to an environment, e.g. `24.3.5.47.altinitystable`.
+type ClickHouseVersion struct {
+	Version        string `json:"version"`
+	Image          string `json:"image"`
+	AltinityStable bool   `json:"altinityStable"`
+	LTS            bool   `json:"lts"`
+}
+
+// ClickHouseVersionData - list of ClickHouse versions returned by get versions.
+type ClickHouseVersionData struct {
+	Versions []ClickHouseVersion `json:"data"`
+}
+
+// IPAllowlistEntry - network allowed to connect to the cluster endpoints, e.g. `10.0.0.0/8`.
+type IPAllowlistEntry struct {
+	CIDR        string `json:"cidr"`
+	Description string `json:"description,omitempty"`
+}
+
+// IPAllowlistData - IP allowlist of a cluster returned by get and set allowlist.
+type IPAllowlistData struct {
+	Entries []IPAllowlistEntry `json:"data"`
+}
+
+// IPAllowlistRequest - body of the set allowlist request.
+type IPAllowlistRequest struct {
+	Entries []IPAllowlistEntry `json:"entries"`
+}
+
+// PrivateEndpointService - AWS PrivateLink or GCP Private Service Connect service of an environment.
+// Consumer VPC endpoints connect to its service name and reach the clusters under its DNS name.
+type PrivateEndpointService struct {
+	EnvID             string   `json:"environment"`
+	Cloud             string   `json:"cloud"`
+	ServiceName       string   `json:"serviceName"`
+	DNSName           string   `json:"dnsName"`
+	AllowedPrincipals []string `json:"allowedPrincipals"`
+	Status            string   `json:"status"`
+}
+
+// PrivateEndpointServiceResponse - response from set and get private endpoint service.
+type PrivateEndpointServiceResponse struct {
+	Metadata struct {
+		Changed bool `json:"changed"`
+	} `json:"metadata"`
+	Data PrivateEndpointService `json:"data"`
+}
+
+// PrivateEndpointConnection - consumer VPC endpoint connected to the private endpoint service of an
+// environment, e.g. the AWS VPC endpoint `vpce-0123456789abcdef0`.
+type PrivateEndpointConnection struct {
+	ID          string `json:"id"`
+	EnvID       string `json:"environment"`
+	EndpointID  string `json:"endpointId"`
+	Description string `json:"description,omitempty"`
+	Status      string `json:"status"`
+	// Error - why the connection was rejected or failed.
+	Error string `json:"error,omitempty"`
+}
+
+// PrivateEndpointConnectionResponse - response from create, update and get private endpoint connection.
+type PrivateEndpointConnectionResponse struct {
+	Metadata struct {
+		Changed bool `json:"changed"`
+	} `json:"metadata"`
+	Data PrivateEndpointConnection `json:"data"`
+}
@@ -0,0 +1,41 @@
+package client
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNodeTypeJSONStrings(t *testing.T) {
+	tests := []struct {
+		name         string
+		body         string
+		nodeSelector NodeSelector
+		extraSpec    JSONString
+	}{
+		{"encoded strings", `{"nodeSelector":"{\"disktype\":\"ssd\"}","extraSpec":"{\"a\":1}"}`, NodeSelector{"disktype": "ssd"}, `{"a":1}`},
+		{"plain objects", `{"nodeSelector":{"disktype":"ssd"},"extraSpec":{"a":1}}`, NodeSelector{"disktype": "ssd"}, `{"a":1}`},
+		{"empty strings", `{"nodeSelector":"","extraSpec":""}`, nil, ""},
+		{"empty objects", `{"nodeSelector":"{}","extraSpec":null}`, nil, ""},
+		{"missing", `{}`, nil, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nt := NodeType{}
+			err := json.Unmarshal([]byte(tt.body), &nt)
+			assert.Nil(t, err)
+			assert.Equal(t, tt.nodeSelector, nt.NodeSelector)
+			assert.Equal(t, tt.extraSpec, nt.ExtraSpec)
+		})
+	}
+}
+
+func TestNodeSelectorString(t *testing.T) {
+	assert.Equal(t, `{"a":"1","b":"2"}`, NodeSelector{"b": "2", "a": "1"}.String())
+	assert.Equal(t, "", NodeSelector{}.String())
+
+	b, err := json.Marshal(NodeType{NodeSelector: NodeSelector{"a": "1"}})
+	assert.Nil(t, err)
+	assert.Contains(t, string(b), `"nodeSelector":"{\"a\":\"1\"}"`)
+}
@@ -1,15 +1,16 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 )
 
 // GetNodeTypes - Returns list of node types from Altinity.Cloud API.
-func (c *AltinityCloudClient) GetNodeTypes(envID string) (NodeTypeData, error) {
+func (c *AltinityCloudClient) GetNodeTypes(ctx context.Context, envID string) (NodeTypeData, error) {
 	requestURL := fmt.Sprintf("%s/environment/%s/nodetypes", c.APIEndpoint, envID)
-	req, err := http.NewRequest("GET", requestURL, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", requestURL, nil)
 	if err != nil {
 		fmt.Printf("client: could not create request: %s\n", err)
 		return NodeTypeData{}, err
@@ -31,12 +32,12 @@ func (c *AltinityCloudClient) GetNodeTypes(envID string) (NodeTypeData, error) {
 	return nt, nil
 }
 
-// GetNodeType - Returns node type by name from Altinity.Cloud API.
-func (c *AltinityCloudClient) GetNodeType(envID, name string) (NodeType, error) {
-	nts, err := c.GetNodeTypes(envID)
+// GetNodeType - Returns node type by name from Altinity.Cloud API, or ErrNotFound if it does not exist.
+func (c *AltinityCloudClient) GetNodeType(ctx context.Context, envID, name string) (NodeType, error) {
+	nts, err := c.GetNodeTypes(ctx, envID)
 	if err != nil {
 		fmt.Printf("client: could not get node types: %s\n", err)
-		return NodeType{}, fmt.Errorf("client: could not get node type: %s", err)
+		return NodeType{}, fmt.Errorf("client: could not get node type: %w", err)
 	}
 
 	// find the node type by name
@@ -46,14 +47,36 @@ func (c *AltinityCloudClient) GetNodeType(envID, name string) (NodeType, error)
 		}
 	}
 
-	// return empty node type if not found
-	return NodeType{}, nil
+	return NodeType{}, fmt.Errorf("client: node type %s in environment %s: %w", name, envID, ErrNotFound)
 }
 
-func (c *AltinityCloudClient) CreateNodeType(envID string, nt NodeType) (NodeType, error) {
+// GetNodeTypeByID - Returns node type by ID from Altinity.Cloud API.
+func (c *AltinityCloudClient) GetNodeTypeByID(ctx context.Context, ID string) (NodeType, error) {
+	requestURL := fmt.Sprintf("%s/nodetype/%s", c.APIEndpoint, ID)
+	req, err := http.NewRequestWithContext(ctx, "GET", requestURL, nil)
+	if err != nil {
+		return NodeType{}, err
+	}
+
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return NodeType{}, err
+	}
+
+	ntr := NodeTypeCreateResponse{}
+	err = json.Unmarshal(body, &ntr)
+	if err != nil {
+		return NodeType{}, err
+	}
+
+	return ntr.Data, nil
+}
+
+// CreateNodeType - Creates node type in an environment.
+func (c *AltinityCloudClient) CreateNodeType(ctx context.Context, envID string, nt NodeType) (NodeType, error) {
 	// build the POST request
 	requestURL := fmt.Sprintf("%s/environment/%s/nodetypes", c.APIEndpoint, envID)
-	req, err := http.NewRequest("POST", requestURL, nil)
+	req, err := http.NewRequestWithContext(ctx, "POST", requestURL, nil)
 	if err != nil {
 		fmt.Printf("client: could not create request: %s\n", err)
 		return NodeType{}, err
@@ -73,9 +96,14 @@ func (c *AltinityCloudClient) CreateNodeType(envID string, nt NodeType) (NodeTyp
 		q.Add("pool", nt.Pool)
 	}
 
-	// add optional params nodeSelector if not null or empty string
+	// add optional params nodeSelector if not null or empty map
 	if len(nt.NodeSelector) > 0 {
-		q.Add("nodeSelector", nt.NodeSelector)
+		q.Add("nodeSelector", nt.NodeSelector.String())
+	}
+
+	// add optional params extraSpec if not null or empty string
+	if len(nt.ExtraSpec) > 0 {
+		q.Add("extraSpec", string(nt.ExtraSpec))
 	}
 
 	// add optional params tolerations if not null or empty string
@@ -109,22 +137,23 @@ func (c *AltinityCloudClient) CreateNodeType(envID string, nt NodeType) (NodeTyp
 	return ntcr.Data, nil
 }
 
-func (c *AltinityCloudClient) UpdateNodeType(envID string, nodeType NodeType) (NodeType, error) {
-	// get the node type
-	n, err := c.GetNodeType(envID, nodeType.Name)
-	if err != nil {
-		fmt.Printf("client: could not get node type: %s\n", err)
-		return NodeType{}, err
+// UpdateNodeType - Updates node type by ID, including its name.
+func (c *AltinityCloudClient) UpdateNodeType(ctx context.Context, nodeType NodeType) (NodeType, error) {
+	if nodeType.ID == "" {
+		return NodeType{}, fmt.Errorf("client: could not update node type %s without ID", nodeType.Name)
 	}
 
 	// build the POST request
-	requestURL := fmt.Sprintf("%s/nodetype/%s", c.APIEndpoint, n.ID)
-	req, err := http.NewRequest("POST", requestURL, nil)
+	requestURL := fmt.Sprintf("%s/nodetype/%s", c.APIEndpoint, nodeType.ID)
+	req, err := http.NewRequestWithContext(ctx, "POST", requestURL, nil)
 	if err != nil {
 		fmt.Printf("client: could not create request: %s\n", err)
 		return NodeType{}, err
 	}
 
+	// updating an existing node type is safe to retry
+	req = retryablePost(req)
+
 	// add the query params
 	q := req.URL.Query()
 	q.Add("name", nodeType.Name)
@@ -139,9 +168,14 @@ func (c *AltinityCloudClient) UpdateNodeType(envID string, nodeType NodeType) (N
 		q.Add("pool", nodeType.Pool)
 	}
 
-	// add optional params nodeSelector if not null or empty string
+	// add optional params nodeSelector if not null or empty map
 	if len(nodeType.NodeSelector) > 0 {
-		q.Add("nodeSelector", nodeType.NodeSelector)
+		q.Add("nodeSelector", nodeType.NodeSelector.String())
+	}
+
+	// add optional params extraSpec if not null or empty string
+	if len(nodeType.ExtraSpec) > 0 {
+		q.Add("extraSpec", string(nodeType.ExtraSpec))
 	}
 
 	// add optional params tolerations if not null or empty string
@@ -175,10 +209,11 @@ func (c *AltinityCloudClient) UpdateNodeType(envID string, nodeType NodeType) (N
 	return ntcr.Data, nil
 }
 
-func (c *AltinityCloudClient) DeleteNodeType(ID string) error {
+// DeleteNodeType - Deletes node type by ID.
+func (c *AltinityCloudClient) DeleteNodeType(ctx context.Context, ID string) error {
 	// build the POST request
 	requestURL := fmt.Sprintf("%s/nodetype/%s", c.APIEndpoint, ID)
-	req, err := http.NewRequest("DELETE", requestURL, nil)
+	req, err := http.NewRequestWithContext(ctx, "DELETE", requestURL, nil)
 	if err != nil {
 		fmt.Printf("client: could not create request: %s\n", err)
 		return err
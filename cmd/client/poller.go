@@ -0,0 +1,184 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"slices"
+	"time"
+)
+
+// DefaultPollInterval - how often long-running operations are polled by default.
+const DefaultPollInterval = 15 * time.Second
+
+// statusDeleted - status reported by the poller once a deleted object is gone.
+const statusDeleted = "deleted"
+
+// Poller - waits for a long-running Altinity.Cloud operation, such as a cluster launch, a backup
+// or a restore, to reach a terminal status. The wait ends when the context is done, so timeouts
+// and interrupted applies are handled through the context.
+type Poller[T any] struct {
+	// Name - describes the operation in logs and errors, e.g. `cluster 42`.
+	Name string
+	// Interval - how long to wait between two status reads.
+	Interval time.Duration
+	// Get - reads the current state of the operation.
+	Get func(ctx context.Context) (T, error)
+	// Status - returns the status of the operation.
+	Status func(T) string
+	// Target - statuses the operation completes with.
+	Target []string
+	// Failed - statuses the operation fails with.
+	Failed []string
+	// Reason - optionally returns why the operation failed.
+	Reason func(T) string
+	// OnPoll - optionally called after every status read, e.g. to log progress.
+	OnPoll func(T)
+}
+
+// OperationFailedError - returned when an operation reports a failed status.
+type OperationFailedError struct {
+	Name   string
+	Status string
+	Reason string
+}
+
+// Error - implements the error interface.
+func (e *OperationFailedError) Error() string {
+	if e.Reason != "" {
+		return fmt.Sprintf("%s reported status %s: %s", e.Name, e.Status, e.Reason)
+	}
+	return fmt.Sprintf("%s reported status %s", e.Name, e.Status)
+}
+
+// WaitError - returned when the context is done before the operation reaches a terminal status.
+type WaitError struct {
+	Name       string
+	LastStatus string
+	Err        error
+}
+
+// Error - implements the error interface.
+func (e *WaitError) Error() string {
+	reason := "timeout"
+	if errors.Is(e.Err, context.Canceled) {
+		reason = "interrupted"
+	}
+	if e.LastStatus == "" {
+		return fmt.Sprintf("%s while waiting for %s: %s", reason, e.Name, e.Err)
+	}
+	return fmt.Sprintf("%s while waiting for %s, last status %s: %s", reason, e.Name, e.LastStatus, e.Err)
+}
+
+// Unwrap - returns the context error.
+func (e *WaitError) Unwrap() error {
+	return e.Err
+}
+
+// IsInterrupted - returns true if a wait was cancelled, e.g. because the apply was interrupted.
+func IsInterrupted(err error) bool {
+	return errors.Is(err, context.Canceled)
+}
+
+// Wait - polls the operation until it reaches a target or failed status, reading it fails or the
+// context is done. The last value read is returned together with any error.
+func (p Poller[T]) Wait(ctx context.Context) (T, error) {
+	var last T
+	var lastStatus string
+	for {
+		v, err := p.Get(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return last, &WaitError{Name: p.Name, LastStatus: lastStatus, Err: ctx.Err()}
+			}
+			return last, err
+		}
+		last, lastStatus = v, p.Status(v)
+
+		tflog.Debug(ctx, fmt.Sprintf("client: %s status is %s, waiting for %v", p.Name, lastStatus, p.Target))
+		if p.OnPoll != nil {
+			p.OnPoll(v)
+		}
+		if slices.Contains(p.Target, lastStatus) {
+			return v, nil
+		}
+		if slices.Contains(p.Failed, lastStatus) {
+			failed := &OperationFailedError{Name: p.Name, Status: lastStatus}
+			if p.Reason != nil {
+				failed.Reason = p.Reason(v)
+			}
+			return v, failed
+		}
+
+		timer := time.NewTimer(p.Interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return last, &WaitError{Name: p.Name, LastStatus: lastStatus, Err: ctx.Err()}
+		case <-timer.C:
+		}
+	}
+}
+
+// WaitForCluster - waits for the cluster to reach the target status, e.g. online after a launch or rescale.
+func (c *AltinityCloudClient) WaitForCluster(ctx context.Context, ID, target string) (Cluster, error) {
+	return Poller[Cluster]{
+		Name:     "cluster " + ID,
+		Interval: c.PollInterval,
+		Get: func(ctx context.Context) (Cluster, error) {
+			return c.GetCluster(ctx, ID)
+		},
+		Status: func(cl Cluster) string { return cl.Status },
+		Target: []string{target},
+		Failed: []string{ClusterStatusFailed},
+	}.Wait(ctx)
+}
+
+// WaitForClusterDeleted - waits until the deleted cluster is gone from Altinity.Cloud.
+func (c *AltinityCloudClient) WaitForClusterDeleted(ctx context.Context, ID string) error {
+	_, err := Poller[Cluster]{
+		Name:     "deletion of cluster " + ID,
+		Interval: c.PollInterval,
+		Get: func(ctx context.Context) (Cluster, error) {
+			cl, err := c.GetCluster(ctx, ID)
+			if IsNotFound(err) {
+				return Cluster{ID: ID, Status: statusDeleted}, nil
+			}
+			return cl, err
+		},
+		Status: func(cl Cluster) string { return cl.Status },
+		Target: []string{statusDeleted},
+	}.Wait(ctx)
+	return err
+}
+
+// WaitForBackup - waits for the backup to complete.
+func (c *AltinityCloudClient) WaitForBackup(ctx context.Context, ID string) (Backup, error) {
+	return Poller[Backup]{
+		Name:     "backup " + ID,
+		Interval: c.PollInterval,
+		Get: func(ctx context.Context) (Backup, error) {
+			return c.GetBackup(ctx, ID)
+		},
+		Status: func(b Backup) string { return b.Status },
+		Target: []string{BackupStatusCompleted},
+		Failed: []string{BackupStatusFailed},
+	}.Wait(ctx)
+}
+
+// WaitForRestore - waits for the restore to finish, onPoll is called with every status read.
+func (c *AltinityCloudClient) WaitForRestore(ctx context.Context, ID string, onPoll func(Restore)) (Restore, error) {
+	return Poller[Restore]{
+		Name:     "restore " + ID,
+		Interval: c.PollInterval,
+		Get: func(ctx context.Context) (Restore, error) {
+			return c.GetRestore(ctx, ID)
+		},
+		Status: func(r Restore) string { return r.Status },
+		Target: []string{RestoreStatusCompleted},
+		Failed: []string{RestoreStatusFailed},
+		Reason: func(r Restore) string { return r.Error },
+		OnPoll: onPoll,
+	}.Wait(ctx)
+}
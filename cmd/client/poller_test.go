@@ -0,0 +1,104 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// testPoller - returns a poller reading the given statuses in order, repeating the last one.
+func testPoller(statuses ...string) (Poller[string], *int) {
+	reads := 0
+	return Poller[string]{
+		Name:     "test",
+		Interval: time.Millisecond,
+		Get: func(ctx context.Context) (string, error) {
+			status := statuses[min(reads, len(statuses)-1)]
+			reads++
+			return status, nil
+		},
+		Status: func(s string) string { return s },
+		Target: []string{"done"},
+		Failed: []string{"failed"},
+	}, &reads
+}
+
+func TestPollerWaitTarget(t *testing.T) {
+	p, reads := testPoller("pending", "pending", "done")
+
+	v, err := p.Wait(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "done", v)
+	assert.Equal(t, 3, *reads, "the poller should stop at the target status")
+}
+
+func TestPollerWaitFailed(t *testing.T) {
+	p, _ := testPoller("pending", "failed")
+	p.Reason = func(s string) string { return "out of disk" }
+
+	v, err := p.Wait(context.Background())
+	assert.Equal(t, "failed", v)
+
+	var failed *OperationFailedError
+	assert.True(t, errors.As(err, &failed))
+	assert.Equal(t, "failed", failed.Status)
+	assert.Equal(t, "test reported status failed: out of disk", err.Error())
+}
+
+func TestPollerWaitTimeout(t *testing.T) {
+	p, _ := testPoller("pending")
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	v, err := p.Wait(ctx)
+	assert.Equal(t, "pending", v, "the last status read should be returned")
+
+	var waitErr *WaitError
+	assert.True(t, errors.As(err, &waitErr))
+	assert.Equal(t, "pending", waitErr.LastStatus)
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	assert.False(t, IsInterrupted(err))
+	assert.Contains(t, err.Error(), "timeout while waiting for test, last status pending")
+}
+
+func TestPollerWaitInterrupted(t *testing.T) {
+	p, reads := testPoller("pending")
+	p.Interval = time.Hour
+	ctx, cancel := context.WithCancel(context.Background())
+	p.OnPoll = func(string) { cancel() }
+
+	_, err := p.Wait(ctx)
+	assert.True(t, IsInterrupted(err))
+	assert.Contains(t, err.Error(), "interrupted while waiting for test")
+	assert.Equal(t, 1, *reads, "an interrupted wait should not poll again")
+}
+
+func TestPollerWaitGetError(t *testing.T) {
+	p, _ := testPoller("pending")
+	p.Get = func(ctx context.Context) (string, error) {
+		return "", &APIError{StatusCode: http.StatusForbidden}
+	}
+
+	_, err := p.Wait(context.Background())
+	assert.True(t, IsUnauthorized(err), "read errors should be returned as is")
+}
+
+func TestWaitForClusterDeleted(t *testing.T) {
+	calls := 0
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 3 {
+			_, _ = w.Write([]byte(`{"data":{"id":"1","status":"deleting"}}`))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	})
+	c.PollInterval = time.Millisecond
+
+	err := c.WaitForClusterDeleted(context.Background(), "1")
+	assert.Nil(t, err)
+	assert.Equal(t, 3, calls, "the cluster is deleted once it is not found")
+}
@@ -0,0 +1,235 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Private endpoint service and connection statuses reported by Altinity.Cloud API.
+const (
+	PrivateEndpointStatusProvisioning = "provisioning"
+	PrivateEndpointStatusPending      = "pending"
+	PrivateEndpointStatusAvailable    = "available"
+	PrivateEndpointStatusRejected     = "rejected"
+	PrivateEndpointStatusFailed       = "failed"
+)
+
+// GetPrivateEndpointService - Returns the private endpoint service of an environment from Altinity.Cloud API.
+func (c *AltinityCloudClient) GetPrivateEndpointService(ctx context.Context, envID string) (PrivateEndpointService, error) {
+	requestURL := fmt.Sprintf("%s/environment/%s/privateendpoint", c.APIEndpoint, envID)
+	req, err := http.NewRequestWithContext(ctx, "GET", requestURL, nil)
+	if err != nil {
+		return PrivateEndpointService{}, err
+	}
+
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return PrivateEndpointService{}, err
+	}
+
+	sr := PrivateEndpointServiceResponse{}
+	err = json.Unmarshal(body, &sr)
+	if err != nil {
+		return PrivateEndpointService{}, err
+	}
+
+	return sr.Data, nil
+}
+
+// SetPrivateEndpointService - Enables the private endpoint service of an environment or changes
+// the principals allowed to connect to it.
+func (c *AltinityCloudClient) SetPrivateEndpointService(ctx context.Context, service PrivateEndpointService) (PrivateEndpointService, error) {
+	// build the POST request
+	requestURL := fmt.Sprintf("%s/environment/%s/privateendpoint", c.APIEndpoint, service.EnvID)
+	req, err := http.NewRequestWithContext(ctx, "POST", requestURL, nil)
+	if err != nil {
+		return PrivateEndpointService{}, err
+	}
+
+	// enabling the service again keeps its service name, so it is safe to retry
+	req = retryablePost(req)
+
+	// add the query params, the principals are sent as a JSON array
+	principals := service.AllowedPrincipals
+	if principals == nil {
+		principals = []string{}
+	}
+	encoded, err := json.Marshal(principals)
+	if err != nil {
+		return PrivateEndpointService{}, err
+	}
+	q := req.URL.Query()
+	q.Add("allowedPrincipals", string(encoded))
+	req.URL.RawQuery = q.Encode()
+
+	// make the request
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return PrivateEndpointService{}, err
+	}
+
+	// unmarshal the response
+	sr := PrivateEndpointServiceResponse{}
+	err = json.Unmarshal(body, &sr)
+	if err != nil {
+		return PrivateEndpointService{}, err
+	}
+
+	return sr.Data, nil
+}
+
+// DeletePrivateEndpointService - Disables the private endpoint service of an environment.
+func (c *AltinityCloudClient) DeletePrivateEndpointService(ctx context.Context, envID string) error {
+	// build the DELETE request
+	requestURL := fmt.Sprintf("%s/environment/%s/privateendpoint", c.APIEndpoint, envID)
+	req, err := http.NewRequestWithContext(ctx, "DELETE", requestURL, nil)
+	if err != nil {
+		return err
+	}
+
+	// make the request
+	_, err = c.doRequest(req, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetPrivateEndpointConnection - Returns a private endpoint connection by ID from Altinity.Cloud API.
+func (c *AltinityCloudClient) GetPrivateEndpointConnection(ctx context.Context, ID string) (PrivateEndpointConnection, error) {
+	requestURL := fmt.Sprintf("%s/privateendpointconnection/%s", c.APIEndpoint, ID)
+	req, err := http.NewRequestWithContext(ctx, "GET", requestURL, nil)
+	if err != nil {
+		return PrivateEndpointConnection{}, err
+	}
+
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return PrivateEndpointConnection{}, err
+	}
+
+	cr := PrivateEndpointConnectionResponse{}
+	err = json.Unmarshal(body, &cr)
+	if err != nil {
+		return PrivateEndpointConnection{}, err
+	}
+
+	return cr.Data, nil
+}
+
+// CreatePrivateEndpointConnection - Requests the approval of a consumer VPC endpoint connecting to
+// the private endpoint service of an environment.
+func (c *AltinityCloudClient) CreatePrivateEndpointConnection(ctx context.Context, envID string, conn PrivateEndpointConnection) (PrivateEndpointConnection, error) {
+	// build the POST request
+	requestURL := fmt.Sprintf("%s/environment/%s/privateendpoint/connections", c.APIEndpoint, envID)
+	req, err := http.NewRequestWithContext(ctx, "POST", requestURL, nil)
+	if err != nil {
+		return PrivateEndpointConnection{}, err
+	}
+
+	// add the query params
+	q := req.URL.Query()
+	q.Add("endpointId", conn.EndpointID)
+	if len(conn.Description) > 0 {
+		q.Add("description", conn.Description)
+	}
+	req.URL.RawQuery = q.Encode()
+
+	// make the request
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return PrivateEndpointConnection{}, err
+	}
+
+	// unmarshal the response
+	cr := PrivateEndpointConnectionResponse{}
+	err = json.Unmarshal(body, &cr)
+	if err != nil {
+		return PrivateEndpointConnection{}, err
+	}
+
+	return cr.Data, nil
+}
+
+// UpdatePrivateEndpointConnection - Updates the description of a private endpoint connection.
+func (c *AltinityCloudClient) UpdatePrivateEndpointConnection(ctx context.Context, conn PrivateEndpointConnection) (PrivateEndpointConnection, error) {
+	// build the POST request
+	requestURL := fmt.Sprintf("%s/privateendpointconnection/%s", c.APIEndpoint, conn.ID)
+	req, err := http.NewRequestWithContext(ctx, "POST", requestURL, nil)
+	if err != nil {
+		return PrivateEndpointConnection{}, err
+	}
+
+	// updating an existing connection is safe to retry
+	req = retryablePost(req)
+
+	// add the query params, an empty description removes it
+	q := req.URL.Query()
+	q.Add("description", conn.Description)
+	req.URL.RawQuery = q.Encode()
+
+	// make the request
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return PrivateEndpointConnection{}, err
+	}
+
+	// unmarshal the response
+	cr := PrivateEndpointConnectionResponse{}
+	err = json.Unmarshal(body, &cr)
+	if err != nil {
+		return PrivateEndpointConnection{}, err
+	}
+
+	return cr.Data, nil
+}
+
+// DeletePrivateEndpointConnection - Rejects and removes a private endpoint connection by ID.
+func (c *AltinityCloudClient) DeletePrivateEndpointConnection(ctx context.Context, ID string) error {
+	// build the DELETE request
+	requestURL := fmt.Sprintf("%s/privateendpointconnection/%s", c.APIEndpoint, ID)
+	req, err := http.NewRequestWithContext(ctx, "DELETE", requestURL, nil)
+	if err != nil {
+		return err
+	}
+
+	// make the request
+	_, err = c.doRequest(req, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// WaitForPrivateEndpointService - waits for the private endpoint service of the environment to be available.
+func (c *AltinityCloudClient) WaitForPrivateEndpointService(ctx context.Context, envID string) (PrivateEndpointService, error) {
+	return Poller[PrivateEndpointService]{
+		Name:     "private endpoint service of environment " + envID,
+		Interval: c.PollInterval,
+		Get: func(ctx context.Context) (PrivateEndpointService, error) {
+			return c.GetPrivateEndpointService(ctx, envID)
+		},
+		Status: func(s PrivateEndpointService) string { return s.Status },
+		Target: []string{PrivateEndpointStatusAvailable},
+		Failed: []string{PrivateEndpointStatusFailed},
+	}.Wait(ctx)
+}
+
+// WaitForPrivateEndpointConnection - waits for the private endpoint connection to be approved.
+func (c *AltinityCloudClient) WaitForPrivateEndpointConnection(ctx context.Context, ID string) (PrivateEndpointConnection, error) {
+	return Poller[PrivateEndpointConnection]{
+		Name:     "private endpoint connection " + ID,
+		Interval: c.PollInterval,
+		Get: func(ctx context.Context) (PrivateEndpointConnection, error) {
+			return c.GetPrivateEndpointConnection(ctx, ID)
+		},
+		Status: func(conn PrivateEndpointConnection) string { return conn.Status },
+		Target: []string{PrivateEndpointStatusAvailable},
+		Failed: []string{PrivateEndpointStatusRejected, PrivateEndpointStatusFailed},
+		Reason: func(conn PrivateEndpointConnection) string { return conn.Error },
+	}.Wait(ctx)
+}
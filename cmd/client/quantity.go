@@ -0,0 +1,95 @@
+package client
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+)
+
+// quantityRegexp - Kubernetes resource quantity, a number with an optional suffix or exponent.
+var quantityRegexp = regexp.MustCompile(`^([+-]?(?:[0-9]+(?:\.[0-9]*)?|\.[0-9]+))([eE][+-]?[0-9]+|m|k|M|G|T|P|E|Ki|Mi|Gi|Ti|Pi|Ei)?$`)
+
+// quantityMultipliers - multipliers of the Kubernetes quantity suffixes.
+var quantityMultipliers = map[string]float64{
+	"m":  1e-3,
+	"k":  1e3,
+	"M":  1e6,
+	"G":  1e9,
+	"T":  1e12,
+	"P":  1e15,
+	"E":  1e18,
+	"Ki": 1 << 10,
+	"Mi": 1 << 20,
+	"Gi": 1 << 30,
+	"Ti": 1 << 40,
+	"Pi": 1 << 50,
+	"Ei": 1 << 60,
+}
+
+// parseQuantity - parses a Kubernetes resource quantity. It returns the value with
+// the suffix applied and whether the quantity had a unit suffix, exponents are plain numbers.
+func parseQuantity(s string) (float64, bool, error) {
+	m := quantityRegexp.FindStringSubmatch(s)
+	if m == nil {
+		return 0, false, fmt.Errorf("%q is not a number or a Kubernetes quantity (e.g. 500m, 4, 16Gi)", s)
+	}
+
+	value, err := strconv.ParseFloat(m[1], 64)
+	if err != nil {
+		return 0, false, err
+	}
+
+	suffix := m[2]
+	unit := false
+	if multiplier, ok := quantityMultipliers[suffix]; ok {
+		value *= multiplier
+		unit = true
+	} else if suffix != "" {
+		exp, err := strconv.Atoi(suffix[1:])
+		if err != nil {
+			return 0, false, err
+		}
+		value *= math.Pow10(exp)
+	}
+
+	if value < 0 || math.IsInf(value, 0) || math.IsNaN(value) {
+		return 0, false, fmt.Errorf("%q must be a non-negative quantity", s)
+	}
+
+	return value, unit, nil
+}
+
+// ParseCPU - parses CPU size in cores, e.g. "4", "0.5" or "500m".
+func ParseCPU(s string) (float64, error) {
+	value, _, err := parseQuantity(s)
+	return value, err
+}
+
+// ParseMemory - parses memory size into MB as used by the API. Plain numbers are
+// already in MB, Kubernetes quantities such as "16Gi" are converted from bytes.
+func ParseMemory(s string) (float64, error) {
+	value, unit, err := parseQuantity(s)
+	if err != nil || !unit {
+		return value, err
+	}
+	return value / (1 << 20), nil
+}
+
+// NormalizeCPU - converts CPU size into the API canonical format, a number of cores, e.g. "500m" to "0.5".
+func NormalizeCPU(s string) (string, error) {
+	value, err := ParseCPU(s)
+	if err != nil {
+		return "", err
+	}
+	return strconv.FormatFloat(value, 'f', -1, 64), nil
+}
+
+// NormalizeMemory - converts memory size into the API canonical format, a whole number of MB, e.g. "16Gi" to "16384".
+func NormalizeMemory(s string) (string, error) {
+	value, err := ParseMemory(s)
+	if err != nil {
+		return "", err
+	}
+	return strconv.FormatFloat(math.Round(value), 'f', -1, 64), nil
+}
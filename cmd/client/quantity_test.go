@@ -0,0 +1,67 @@
+package client
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestParseCPU(t *testing.T) {
+	tests := map[string]float64{
+		"4":    4,
+		"4.0":  4,
+		"0.5":  0.5,
+		"500m": 0.5,
+		"1e1":  10,
+		"2k":   2000,
+	}
+	for in, want := range tests {
+		got, err := ParseCPU(in)
+		assert.Nil(t, err, in)
+		assert.InDelta(t, want, got, 1e-9, in)
+	}
+
+	for _, in := range []string{"", "four", "4 cores", "-1", "4Gb"} {
+		_, err := ParseCPU(in)
+		assert.NotNil(t, err, in)
+	}
+}
+
+func TestParseMemory(t *testing.T) {
+	tests := map[string]float64{
+		"16384":     16384,
+		"16Gi":      16384,
+		"512Mi":     512,
+		"1Ti":       1048576,
+		"1048576Ki": 1024,
+		"1e3":       1000,
+	}
+	for in, want := range tests {
+		got, err := ParseMemory(in)
+		assert.Nil(t, err, in)
+		assert.InDelta(t, want, got, 1e-9, in)
+	}
+
+	_, err := ParseMemory("16GB")
+	assert.NotNil(t, err)
+}
+
+func TestNormalizeQuantity(t *testing.T) {
+	cpu := map[string]string{"4": "4", "4.0": "4", "500m": "0.5", "1500m": "1.5"}
+	for in, want := range cpu {
+		got, err := NormalizeCPU(in)
+		assert.Nil(t, err, in)
+		assert.Equal(t, want, got, in)
+	}
+
+	memory := map[string]string{"16384": "16384", "16Gi": "16384", "16384.0": "16384", "1G": "954"}
+	for in, want := range memory {
+		got, err := NormalizeMemory(in)
+		assert.Nil(t, err, in)
+		assert.Equal(t, want, got, in)
+	}
+
+	_, err := NormalizeCPU("lots")
+	assert.NotNil(t, err)
+	_, err = NormalizeMemory("lots")
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,68 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// Restore statuses reported by Altinity.Cloud API.
+const (
+	RestoreStatusInProgress = "in_progress"
+	RestoreStatusCompleted  = "completed"
+	RestoreStatusFailed     = "failed"
+)
+
+// RestoreCluster - Starts restoring a backup into a cluster. The restore runs in the background.
+func (c *AltinityCloudClient) RestoreCluster(ctx context.Context, clusterID, backupID string) (Restore, error) {
+	// build the POST request
+	requestURL := fmt.Sprintf("%s/cluster/%s/restore", c.APIEndpoint, clusterID)
+	req, err := http.NewRequestWithContext(ctx, "POST", requestURL, nil)
+	if err != nil {
+		return Restore{}, err
+	}
+
+	// add the query params
+	q := url.Values{}
+	q.Add("backup", backupID)
+	req.URL.RawQuery = q.Encode()
+
+	// make the request
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return Restore{}, err
+	}
+
+	// unmarshal the response
+	rr := RestoreResponse{}
+	err = json.Unmarshal(body, &rr)
+	if err != nil {
+		return Restore{}, err
+	}
+
+	return rr.Data, nil
+}
+
+// GetRestore - Returns restore operation by ID from Altinity.Cloud API.
+func (c *AltinityCloudClient) GetRestore(ctx context.Context, ID string) (Restore, error) {
+	requestURL := fmt.Sprintf("%s/restore/%s", c.APIEndpoint, ID)
+	req, err := http.NewRequestWithContext(ctx, "GET", requestURL, nil)
+	if err != nil {
+		return Restore{}, err
+	}
+
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return Restore{}, err
+	}
+
+	rr := RestoreResponse{}
+	err = json.Unmarshal(body, &rr)
+	if err != nil {
+		return Restore{}, err
+	}
+
+	return rr.Data, nil
+}
@@ -0,0 +1,21 @@
+terraform {
+  required_providers {
+    altinitycloud = {
+      source = "tatari.tv/dev/altinitycloud"
+    }
+  }
+}
+
+provider "altinitycloud" {
+  api_endpoint = "https://acm.altinity.cloud/api"
+  // set API token via environment variable ALTINITYCLOUD_API_TOKEN
+}
+
+data "altinitycloud_backups" "example" {
+  cluster_id = "42"
+}
+
+// backups are listed newest first
+output "latest_backup" {
+  value = data.altinitycloud_backups.example.backups[0].name
+}
@@ -0,0 +1,23 @@
+terraform {
+  required_providers {
+    altinitycloud = {
+      source = "tatari.tv/dev/altinitycloud"
+    }
+  }
+}
+
+provider "altinitycloud" {
+  api_endpoint = "https://acm.altinity.cloud/api"
+  // set API token via environment variable ALTINITYCLOUD_API_TOKEN
+}
+
+// newest Altinity Stable long-term support build
+data "altinitycloud_clickhouse_versions" "stable" {
+  env_id          = "648"
+  altinity_stable = true
+  lts             = true
+}
+
+output "latest_stable_version" {
+  value = data.altinitycloud_clickhouse_versions.stable.latest
+}
@@ -0,0 +1,38 @@
+terraform {
+  required_providers {
+    altinitycloud = {
+      source = "tatari.tv/dev/altinitycloud"
+    }
+    kubernetes = {
+      source = "hashicorp/kubernetes"
+    }
+  }
+}
+
+provider "altinitycloud" {
+  api_endpoint = "https://acm.altinity.cloud/api"
+  // set API token via environment variable ALTINITYCLOUD_API_TOKEN
+}
+
+data "altinitycloud_cluster_endpoints" "example" {
+  cluster_id = "42"
+}
+
+// hand the connection details to the apps instead of copy-pasting them
+resource "kubernetes_secret" "clickhouse" {
+  metadata {
+    name      = "clickhouse"
+    namespace = "analytics"
+  }
+
+  data = {
+    host     = data.altinitycloud_cluster_endpoints.example.host
+    port     = data.altinitycloud_cluster_endpoints.example.native_tls_port
+    url      = "https://${data.altinitycloud_cluster_endpoints.example.host}:${data.altinitycloud_cluster_endpoints.example.https_port}"
+    "ca.crt" = data.altinitycloud_cluster_endpoints.example.ca_certificate
+  }
+}
+
+output "replica_hosts" {
+  value = data.altinitycloud_cluster_endpoints.example.replicas[*].host
+}
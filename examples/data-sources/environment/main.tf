@@ -0,0 +1,22 @@
+terraform {
+  required_providers {
+    altinitycloud = {
+      source = "tatari.tv/dev/altinitycloud"
+    }
+  }
+}
+
+provider "altinitycloud" {
+  api_endpoint = "https://acm.altinity.cloud/api"
+  // set API token via environment variable ALTINITYCLOUD_API_TOKEN
+}
+
+data "altinitycloud_environment" "example" {
+  name = "tatari-prod"
+}
+
+// use the resolved environment ID instead of copying it from the ACM UI
+data "altinitycloud_node_types" "example" {
+  env_id = data.altinitycloud_environment.example.id
+  scope  = "ClickHouse"
+}
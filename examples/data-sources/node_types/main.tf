@@ -0,0 +1,23 @@
+terraform {
+  required_providers {
+    altinitycloud = {
+      source = "tatari.tv/dev/altinitycloud"
+    }
+  }
+}
+
+provider "altinitycloud" {
+  api_endpoint = "https://acm.altinity.cloud/api"
+  // set API token via environment variable ALTINITYCLOUD_API_TOKEN
+}
+
+// smallest ClickHouse node type with at least 16 cores
+data "altinitycloud_node_types" "example" {
+  env_id  = "648"
+  scope   = "ClickHouse"
+  min_cpu = 16
+}
+
+output "node_type" {
+  value = data.altinitycloud_node_types.example.node_types[0].name
+}
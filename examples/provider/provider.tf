@@ -6,6 +6,17 @@ terraform {
   }
 }
 
+// take the endpoint and token of the analytics organization from ~/.altinity/credentials:
+//
+// [analytics]
+// api_endpoint = https://acm.altinity.cloud/api
+// api_token    = ...
 provider "altinitycloud" {
+  profile = "analytics"
+}
 
-}
\ No newline at end of file
+// a self-hosted ACM with its own profile
+provider "altinitycloud" {
+  alias   = "self_hosted"
+  profile = "self-hosted"
+}
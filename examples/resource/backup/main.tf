@@ -0,0 +1,18 @@
+terraform {
+  required_providers {
+    altinitycloud = {
+      source = "tatari.tv/dev/altinitycloud"
+    }
+  }
+}
+
+provider "altinitycloud" {
+  api_endpoint = "https://acm.altinity.cloud/api"
+  // set API token via environment variable ALTINITYCLOUD_API_TOKEN
+}
+
+// take a backup before upgrading the cluster, bump the name to take a new one
+resource "altinitycloud_backup" "example" {
+  cluster_id = "42"
+  name       = "before-24-8-upgrade"
+}
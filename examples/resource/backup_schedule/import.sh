@@ -0,0 +1,2 @@
+# The backup schedule of a cluster can be imported by the cluster ID.
+terraform import altinitycloud_backup_schedule.example 42
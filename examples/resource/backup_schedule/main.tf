@@ -0,0 +1,21 @@
+terraform {
+  required_providers {
+    altinitycloud = {
+      source = "tatari.tv/dev/altinitycloud"
+    }
+  }
+}
+
+provider "altinitycloud" {
+  api_endpoint = "https://acm.altinity.cloud/api"
+  // set API token via environment variable ALTINITYCLOUD_API_TOKEN
+}
+
+// keep a week of daily backups in a dedicated bucket
+resource "altinitycloud_backup_schedule" "example" {
+  cluster_id  = "42"
+  schedule    = "0 3 * * *"
+  retention   = 7
+  bucket      = "tatari-clickhouse-backups"
+  compression = "zstd"
+}
@@ -0,0 +1,5 @@
+# Clusters can be imported by their Altinity.Cloud ID. The admin password is never
+# returned by the API, set it in the configuration after the import. The first apply
+# only saves it to state and keeps the live admin password, change it afterwards to
+# reset the live password.
+terraform import altinitycloud_cluster.example 42
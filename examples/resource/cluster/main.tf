@@ -0,0 +1,59 @@
+terraform {
+  required_providers {
+    altinitycloud = {
+      source = "tatari.tv/dev/altinitycloud"
+    }
+  }
+}
+
+provider "altinitycloud" {
+  api_endpoint = "https://acm.altinity.cloud/api"
+  // set API token via environment variable ALTINITYCLOUD_API_TOKEN
+}
+
+variable "admin_password" {
+  type      = string
+  sensitive = true
+}
+
+data "altinitycloud_clickhouse_versions" "stable" {
+  env_id          = "648"
+  altinity_stable = true
+  lts             = true
+}
+
+// upgraded in place with a rolling upgrade when a newer stable build is released
+resource "altinitycloud_cluster" "example" {
+  env_id         = "648"
+  name           = "tf-example"
+  version        = data.altinitycloud_clickhouse_versions.stable.latest
+  node_type      = "m6a.xlarge"
+  shards         = 1
+  replicas       = 2
+  disk_size      = 100
+  admin_password = var.admin_password
+
+  // shards, replicas, node_type and growing disk_size are rescaled in place
+  timeouts {
+    update = "2h"
+  }
+}
+
+// clone the latest nightly backup of the example cluster into staging
+resource "altinitycloud_cluster" "staging" {
+  env_id         = "649"
+  name           = "tf-example-staging"
+  version        = "24.3.5.47.altinitystable"
+  node_type      = "m6a.xlarge"
+  disk_size      = 100
+  admin_password = var.admin_password
+
+  restore_from = {
+    source_cluster_id = altinitycloud_cluster.example.id
+    backup_timestamp  = "2024-05-01T03:00:00Z"
+  }
+
+  timeouts {
+    create = "3h"
+  }
+}
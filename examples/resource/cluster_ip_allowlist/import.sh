@@ -0,0 +1,4 @@
+# The IP allowlist of a cluster can be imported by the cluster ID in merge mode, or by
+# "<cluster_id>/<mode>". Every entry the cluster has is managed after the import.
+terraform import altinitycloud_cluster_ip_allowlist.example 42
+terraform import altinitycloud_cluster_ip_allowlist.example 42/authoritative
@@ -0,0 +1,30 @@
+terraform {
+  required_providers {
+    altinitycloud = {
+      source = "tatari.tv/dev/altinitycloud"
+    }
+  }
+}
+
+provider "altinitycloud" {
+  api_endpoint = "https://acm.altinity.cloud/api"
+  // set API token via environment variable ALTINITYCLOUD_API_TOKEN
+}
+
+// only allow the office and the application VPC to connect to the production cluster
+resource "altinitycloud_cluster_ip_allowlist" "production" {
+  cluster_id = "42"
+  mode       = "authoritative"
+  entries = [
+    { cidr = "203.0.113.0/24", description = "office" },
+    { cidr = "10.20.0.0/16", description = "application VPC" },
+  ]
+}
+
+// add the CI runner to the staging cluster, keeping entries added in the Altinity.Cloud UI
+resource "altinitycloud_cluster_ip_allowlist" "staging_ci" {
+  cluster_id = "43"
+  entries = [
+    { cidr = "198.51.100.7", description = "CI runner" },
+  ]
+}
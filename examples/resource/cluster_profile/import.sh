@@ -0,0 +1,2 @@
+# Cluster profiles can be imported by "<cluster_id>/<profile_name>".
+terraform import altinitycloud_cluster_profile.example 42/tenant
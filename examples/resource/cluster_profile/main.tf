@@ -0,0 +1,36 @@
+terraform {
+  required_providers {
+    altinitycloud = {
+      source = "tatari.tv/dev/altinitycloud"
+    }
+  }
+}
+
+provider "altinitycloud" {
+  api_endpoint = "https://acm.altinity.cloud/api"
+  // set API token via environment variable ALTINITYCLOUD_API_TOKEN
+}
+
+resource "altinitycloud_cluster_profile" "tenant" {
+  cluster_id  = "42"
+  name        = "tenant"
+  description = "Limits for tenant queries"
+  settings = {
+    max_memory_usage   = "10000000000"
+    max_execution_time = "300"
+    readonly           = "1"
+  }
+}
+
+variable "tenant_password" {
+  type      = string
+  sensitive = true
+}
+
+// users refer to the profile by name
+resource "altinitycloud_cluster_user" "tenant" {
+  cluster_id = "42"
+  username   = "tenant"
+  password   = var.tenant_password
+  profile    = altinitycloud_cluster_profile.tenant.name
+}
@@ -0,0 +1,2 @@
+# The uptime schedule of a cluster can be imported by the cluster ID.
+terraform import altinitycloud_cluster_schedule.example 42
@@ -0,0 +1,33 @@
+terraform {
+  required_providers {
+    altinitycloud = {
+      source = "tatari.tv/dev/altinitycloud"
+    }
+  }
+}
+
+provider "altinitycloud" {
+  api_endpoint = "https://acm.altinity.cloud/api"
+  // set API token via environment variable ALTINITYCLOUD_API_TOKEN
+}
+
+// run the staging cluster during office hours and stop it at night and on weekends
+resource "altinitycloud_cluster_schedule" "staging" {
+  cluster_id = "42"
+  mode       = "weekly"
+  timezone   = "America/New_York"
+  days = {
+    monday    = { start = "08:00", stop = "20:00" }
+    tuesday   = { start = "08:00", stop = "20:00" }
+    wednesday = { start = "08:00", stop = "20:00" }
+    thursday  = { start = "08:00", stop = "20:00" }
+    friday    = { start = "08:00", stop = "18:00" }
+  }
+}
+
+// stop the dev cluster when nobody used it for four hours
+resource "altinitycloud_cluster_schedule" "dev" {
+  cluster_id     = "43"
+  mode           = "stop_when_inactive"
+  inactive_hours = 4
+}
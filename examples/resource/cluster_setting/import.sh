@@ -0,0 +1,2 @@
+# Cluster settings can be imported by "<cluster_id>/<setting_name>".
+terraform import altinitycloud_cluster_setting.example 42/max_connections
@@ -0,0 +1,34 @@
+terraform {
+  required_providers {
+    altinitycloud = {
+      source = "tatari.tv/dev/altinitycloud"
+    }
+  }
+}
+
+provider "altinitycloud" {
+  api_endpoint = "https://acm.altinity.cloud/api"
+  // set API token via environment variable ALTINITYCLOUD_API_TOKEN
+}
+
+resource "altinitycloud_cluster_setting" "max_connections" {
+  cluster_id = "42"
+  name       = "max_connections"
+  value      = "4096"
+}
+
+resource "altinitycloud_cluster_setting" "logger" {
+  cluster_id   = "42"
+  name         = "config.d/logger.xml"
+  file_content = <<-EOT
+    <clickhouse>
+      <logger>
+        <level>information</level>
+      </logger>
+    </clickhouse>
+  EOT
+
+  // the logger config is only read on startup, restart the cluster after changes
+  restart_required  = true
+  restart_on_change = true
+}
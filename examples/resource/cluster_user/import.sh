@@ -0,0 +1,3 @@
+# Cluster users can be imported by their Altinity.Cloud ID. The password is never
+# returned by the API, set it in the configuration after the import.
+terraform import altinitycloud_cluster_user.example 7
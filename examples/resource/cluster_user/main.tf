@@ -0,0 +1,30 @@
+terraform {
+  required_providers {
+    altinitycloud = {
+      source = "tatari.tv/dev/altinitycloud"
+    }
+  }
+}
+
+provider "altinitycloud" {
+  api_endpoint = "https://acm.altinity.cloud/api"
+  // set API token via environment variable ALTINITYCLOUD_API_TOKEN
+}
+
+variable "app_password" {
+  type      = string
+  sensitive = true
+}
+
+resource "altinitycloud_cluster_user" "example" {
+  cluster_id = "42"
+  username   = "app"
+  profile    = "default"
+  networks   = ["10.0.0.0/8"]
+  databases  = ["events"]
+
+  // on Terraform 1.11 and later the password can be kept out of the state,
+  // bump password_wo_version to change it
+  password_wo         = var.app_password
+  password_wo_version = 1
+}
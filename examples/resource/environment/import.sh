@@ -0,0 +1,2 @@
+# Environments can be imported by their Altinity.Cloud ID.
+terraform import altinitycloud_environment.example 648
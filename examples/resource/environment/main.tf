@@ -0,0 +1,21 @@
+terraform {
+  required_providers {
+    altinitycloud = {
+      source = "tatari.tv/dev/altinitycloud"
+    }
+  }
+}
+
+provider "altinitycloud" {
+  api_endpoint = "https://acm.altinity.cloud/api"
+  // set API token via environment variable ALTINITYCLOUD_API_TOKEN
+}
+
+resource "altinitycloud_environment" "example" {
+  name                         = "tf-example"
+  cloud                        = "aws"
+  region                       = "us-east-1"
+  zones                        = ["us-east-1a", "us-east-1b"]
+  default_clickhouse_node_type = "m6i.xlarge"
+  default_zookeeper_node_type  = "t3.large"
+}
@@ -0,0 +1,2 @@
+# Node types can be imported by "<env_id>/<node_type_name>" or "<env_id>/<node_type_id>".
+terraform import altinitycloud_node_type.example 648/tf_example
@@ -15,12 +15,15 @@ resource "altinitycloud_node_type" "example" {
   env_id = "648"
   node_type = {
     name          = "tf_example"
-    scope         = "clickhouse"
+    scope         = "ClickHouse"
     code          = "danmahoneyexample"
     storage_class = "gp3"
     memory        = "8192"
     cpu           = "4"
     pool          = "m6a.xlarge"
+    node_selector = {
+      "node.kubernetes.io/instance-type" = "m6a.xlarge"
+    }
     tolerations = [
       {
         key      = "dedicated"
@@ -0,0 +1,2 @@
+# A private endpoint connection can be imported by its Altinity.Cloud ID.
+terraform import altinitycloud_private_endpoint_connection.example 1234
@@ -0,0 +1,37 @@
+terraform {
+  required_providers {
+    altinitycloud = {
+      source = "tatari.tv/dev/altinitycloud"
+    }
+    aws = {
+      source = "hashicorp/aws"
+    }
+  }
+}
+
+provider "altinitycloud" {
+  api_endpoint = "https://acm.altinity.cloud/api"
+  // set API token via environment variable ALTINITYCLOUD_API_TOKEN
+}
+
+resource "altinitycloud_private_endpoint_service" "example" {
+  env_id             = "648"
+  allowed_principals = ["arn:aws:iam::123456789012:root"]
+}
+
+// consumer side endpoint in our VPC, connected to the Altinity.Cloud service
+resource "aws_vpc_endpoint" "altinity" {
+  vpc_id              = "vpc-0123456789abcdef0"
+  service_name        = altinitycloud_private_endpoint_service.example.service_name
+  vpc_endpoint_type   = "Interface"
+  subnet_ids          = ["subnet-0123456789abcdef0"]
+  security_group_ids  = ["sg-0123456789abcdef0"]
+  private_dns_enabled = false
+}
+
+// approve the endpoint, clusters are then reachable under the service dns_name
+resource "altinitycloud_private_endpoint_connection" "example" {
+  env_id      = altinitycloud_private_endpoint_service.example.env_id
+  endpoint_id = aws_vpc_endpoint.altinity.id
+  description = "analytics VPC"
+}
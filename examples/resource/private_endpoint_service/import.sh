@@ -0,0 +1,2 @@
+# The private endpoint service of an environment can be imported by the environment ID.
+terraform import altinitycloud_private_endpoint_service.example 648
@@ -0,0 +1,27 @@
+terraform {
+  required_providers {
+    altinitycloud = {
+      source = "tatari.tv/dev/altinitycloud"
+    }
+  }
+}
+
+provider "altinitycloud" {
+  api_endpoint = "https://acm.altinity.cloud/api"
+  // set API token via environment variable ALTINITYCLOUD_API_TOKEN
+}
+
+// expose the clusters of an AWS environment over PrivateLink to our account only
+resource "altinitycloud_private_endpoint_service" "example" {
+  env_id             = "648"
+  allowed_principals = ["arn:aws:iam::123456789012:root"]
+}
+
+// wire these into the aws_vpc_endpoint of the consumer VPC
+output "service_name" {
+  value = altinitycloud_private_endpoint_service.example.service_name
+}
+
+output "dns_name" {
+  value = altinitycloud_private_endpoint_service.example.dns_name
+}
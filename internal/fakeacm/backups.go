@@ -0,0 +1,190 @@
+package fakeacm
+
+import (
+	"fmt"
+	"github.com/tatari-tv/terraform-provider-altinitycloud/cmd/client"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// DefaultBackupBucket - bucket the fake API stores backups in when the schedule does not name one.
+const DefaultBackupBucket = "acm-backups"
+
+// backup - backup stored together with the number of status reads left until it completes.
+type backup struct {
+	client.Backup
+	polls int
+}
+
+// getBackupSchedule - GET /cluster/{id}/backupschedule
+func (s *Server) getBackupSchedule(w http.ResponseWriter, r *http.Request) {
+	clusterID := r.PathValue("id")
+
+	s.mu.Lock()
+	bs, ok := s.backupSchedules[clusterID]
+	s.mu.Unlock()
+	if !ok {
+		writeNotFound(w, "backup schedule", clusterID)
+		return
+	}
+	writeData(w, bs)
+}
+
+// setBackupSchedule - POST /cluster/{id}/backupschedule
+func (s *Server) setBackupSchedule(w http.ResponseWriter, r *http.Request) {
+	clusterID := r.PathValue("id")
+	if !requireParams(w, r, "schedule", "retention", "compression") {
+		return
+	}
+
+	q := r.URL.Query()
+	retention, err := strconv.ParseInt(q.Get("retention"), 10, 64)
+	if err != nil || retention < 1 {
+		writeError(w, http.StatusUnprocessableEntity, "validation_failed", "invalid request",
+			[]client.FieldError{{Field: "retention", Message: "must be a positive number"}})
+		return
+	}
+	bs := client.BackupSchedule{
+		ClusterID:   clusterID,
+		Schedule:    q.Get("schedule"),
+		Retention:   retention,
+		Bucket:      q.Get("bucket"),
+		Compression: q.Get("compression"),
+	}
+	if bs.Bucket == "" {
+		bs.Bucket = DefaultBackupBucket
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.clusters[clusterID]; !ok {
+		writeNotFound(w, "cluster", clusterID)
+		return
+	}
+	s.backupSchedules[clusterID] = bs
+	writeData(w, bs)
+}
+
+// deleteBackupSchedule - DELETE /cluster/{id}/backupschedule
+func (s *Server) deleteBackupSchedule(w http.ResponseWriter, r *http.Request) {
+	clusterID := r.PathValue("id")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	bs, ok := s.backupSchedules[clusterID]
+	if !ok {
+		writeNotFound(w, "backup schedule", clusterID)
+		return
+	}
+	delete(s.backupSchedules, clusterID)
+	writeData(w, bs)
+}
+
+// listBackups - GET /cluster/{id}/backups
+func (s *Server) listBackups(w http.ResponseWriter, r *http.Request) {
+	clusterID := r.PathValue("id")
+
+	s.mu.Lock()
+	bs := []client.Backup{}
+	for _, b := range s.backups {
+		if b.ClusterID == clusterID {
+			bs = append(bs, b.Backup)
+		}
+	}
+	s.mu.Unlock()
+
+	sort.Slice(bs, func(i, j int) bool { return bs[i].ID < bs[j].ID })
+	writeData(w, bs)
+}
+
+// createBackup - POST /cluster/{id}/backups
+func (s *Server) createBackup(w http.ResponseWriter, r *http.Request) {
+	clusterID := r.PathValue("id")
+	if !requireParams(w, r, "name") {
+		return
+	}
+	name := r.URL.Query().Get("name")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.clusters[clusterID]; !ok {
+		writeNotFound(w, "cluster", clusterID)
+		return
+	}
+	for _, other := range s.backups {
+		if other.ClusterID == clusterID && other.Name == name {
+			writeError(w, http.StatusConflict, "conflict", fmt.Sprintf("backup %s already exists", name), nil)
+			return
+		}
+	}
+	b := client.Backup{
+		ID:        s.newID(),
+		ClusterID: clusterID,
+		Name:      name,
+		Status:    client.BackupStatusInProgress,
+		CreatedAt: time.Now().UTC().Format(time.RFC3339),
+	}
+	s.backups[b.ID] = backup{Backup: b, polls: s.BackupPolls}
+	writeData(w, b)
+}
+
+// getBackup - GET /backup/{id}
+func (s *Server) getBackup(w http.ResponseWriter, r *http.Request) {
+	ID := r.PathValue("id")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b, ok := s.backups[ID]
+	if !ok {
+		writeNotFound(w, "backup", ID)
+		return
+	}
+
+	// every status read brings a running backup closer to completion
+	if b.Status == client.BackupStatusInProgress {
+		if b.polls > 0 {
+			b.polls--
+		} else {
+			b.Status = client.BackupStatusCompleted
+			b.Size = 1 << 30
+		}
+		s.backups[ID] = b
+	}
+	writeData(w, b.Backup)
+}
+
+// deleteBackup - DELETE /backup/{id}
+func (s *Server) deleteBackup(w http.ResponseWriter, r *http.Request) {
+	ID := r.PathValue("id")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b, ok := s.backups[ID]
+	if !ok {
+		writeNotFound(w, "backup", ID)
+		return
+	}
+	delete(s.backups, ID)
+	writeData(w, b.Backup)
+}
+
+// AddBackup - stores a backup, e.g. one taken by the backup schedule.
+func (s *Server) AddBackup(b client.Backup) client.Backup {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if b.ID == "" {
+		b.ID = s.newID()
+	}
+	s.backups[b.ID] = backup{Backup: b}
+	return b
+}
+
+// BackupSchedule - returns the stored backup schedule of a cluster.
+func (s *Server) BackupSchedule(clusterID string) (client.BackupSchedule, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	bs, ok := s.backupSchedules[clusterID]
+	return bs, ok
+}
@@ -0,0 +1,89 @@
+package fakeacm
+
+import (
+	"github.com/tatari-tv/terraform-provider-altinitycloud/cmd/client"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// DefaultClickHouseVersions - ClickHouse versions the fake API offers to every environment.
+var DefaultClickHouseVersions = []client.ClickHouseVersion{
+	{Version: "23.8.16.42.altinitystable", Image: "altinity/clickhouse-server:23.8.16.42.altinitystable", AltinityStable: true, LTS: true},
+	{Version: "24.3.5.47.altinitystable", Image: "altinity/clickhouse-server:24.3.5.47.altinitystable", AltinityStable: true, LTS: true},
+	{Version: "24.3.12.75.altinitystable", Image: "altinity/clickhouse-server:24.3.12.75.altinitystable", AltinityStable: true, LTS: true},
+	{Version: "24.8.14.10459.altinitystable", Image: "altinity/clickhouse-server:24.8.14.10459.altinitystable", AltinityStable: true, LTS: true},
+	{Version: "25.1.3.23", Image: "clickhouse/clickhouse-server:25.1.3.23"},
+}
+
+// listClickHouseVersions - GET /environment/{id}/versions
+func (s *Server) listClickHouseVersions(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	vs := append([]client.ClickHouseVersion{}, s.ClickHouseVersions...)
+	s.mu.Unlock()
+
+	writeData(w, vs)
+}
+
+// upgradeCluster - POST /cluster/{id}/upgrade
+func (s *Server) upgradeCluster(w http.ResponseWriter, r *http.Request) {
+	ID := r.PathValue("id")
+	if !requireParams(w, r, "version") {
+		return
+	}
+	q := r.URL.Query()
+	version := q.Get("version")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c, ok := s.clusters[ID]
+	if !ok {
+		writeNotFound(w, "cluster", ID)
+		return
+	}
+	if !s.versionAvailable(version) {
+		writeError(w, http.StatusUnprocessableEntity, "validation_failed", "invalid request",
+			[]client.FieldError{{Field: "version", Message: "version " + version + " is not available"}})
+		return
+	}
+	if compareVersions(version, c.Version) < 0 && q.Get("allowDowngrade") != "true" {
+		writeError(w, http.StatusUnprocessableEntity, "validation_failed", "invalid request",
+			[]client.FieldError{{Field: "version", Message: "downgrade from " + c.Version + " is not allowed"}})
+		return
+	}
+
+	c.Version = version
+	c.Status = client.ClusterStatusUpgrading
+	c.launchPolls = s.ClusterLaunchPolls
+	s.clusters[ID] = c
+	writeData(w, c.public())
+}
+
+// versionAvailable - reports whether the version is offered, callers hold the lock.
+func (s *Server) versionAvailable(version string) bool {
+	for _, v := range s.ClickHouseVersions {
+		if v.Version == version {
+			return true
+		}
+	}
+	return false
+}
+
+// compareVersions - compares the numeric parts of two ClickHouse versions, e.g. `24.3.5.47.altinitystable`.
+func compareVersions(a, b string) int {
+	as, bs := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(as) && i < len(bs); i++ {
+		an, aErr := strconv.Atoi(as[i])
+		bn, bErr := strconv.Atoi(bs[i])
+		if aErr != nil || bErr != nil {
+			break
+		}
+		if an != bn {
+			if an < bn {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
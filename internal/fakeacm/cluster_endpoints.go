@@ -0,0 +1,68 @@
+package fakeacm
+
+import (
+	"fmt"
+	"github.com/tatari-tv/terraform-provider-altinitycloud/cmd/client"
+	"net/http"
+	"strings"
+)
+
+// CACertificate - self-signed CA certificate returned for the endpoints of every cluster.
+const CACertificate = `-----BEGIN CERTIFICATE-----
+MIIBmjCCAT+gAwIBAgIUByXPchVRpkcYw17dX2cln8pdXrYwCgYIKoZIzj0EAwIw
+ITEfMB0GA1UEAwwWQWx0aW5pdHkuQ2xvdWQgRmFrZSBDQTAgFw0yNjEwMTgwOTU4
+NTBaGA8yMTI2MDkyNDA5NTg1MFowITEfMB0GA1UEAwwWQWx0aW5pdHkuQ2xvdWQg
+RmFrZSBDQTBZMBMGByqGSM49AgEGCCqGSM49AwEHA0IABP+0p7u5ObXD+0+oEK4r
+C4dV+ADJnw/yNq+WUuT5YJD+DG2oqeNbD8A5nAIEzCLAEKBOiDw5d6DcZ8NNvplo
+re6jUzBRMB0GA1UdDgQWBBQ88MOroH1EhpWlHvEsQm/SV2s7TTAfBgNVHSMEGDAW
+gBQ88MOroH1EhpWlHvEsQm/SV2s7TTAPBgNVHRMBAf8EBTADAQH/MAoGCCqGSM49
+BAMCA0kAMEYCIQDCDF77Yl/oFi95IeCb/2IdBTPkCSmoIQviEwcTIXxhYgIhAM5B
+6V2eiXhU2fX8keO6xRPLugGzQ74UrsIG9h+kmBkr
+-----END CERTIFICATE-----
+`
+
+// getClusterEndpoints - GET /cluster/{id}/endpoints
+func (s *Server) getClusterEndpoints(w http.ResponseWriter, r *http.Request) {
+	ID := r.PathValue("id")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c, ok := s.clusters[ID]
+	if !ok {
+		writeNotFound(w, "cluster", ID)
+		return
+	}
+
+	// environments are not always seeded, their ID stands in for the name
+	envName := c.EnvID
+	if env, ok := s.environments[c.EnvID]; ok {
+		envName = strings.ToLower(env.Name)
+	}
+	ep := client.ClusterEndpoints{
+		ClusterID:     ID,
+		DNSName:       fmt.Sprintf("%s.%s.altinity.cloud", c.Name, envName),
+		HTTPPort:      8123,
+		HTTPSPort:     8443,
+		NativePort:    9000,
+		NativeTLSPort: 9440,
+		Replicas:      []client.ReplicaEndpoint{},
+		CACertificate: CACertificate,
+	}
+
+	// apps connect through the private endpoint once the environment has one
+	ep.Host = ep.DNSName
+	if ps, ok := s.endpointServices[c.EnvID]; ok && ps.Status == client.PrivateEndpointStatusAvailable {
+		ep.Host = c.Name + "." + ps.DNSName
+	}
+
+	for shard := int64(0); shard < c.Shards; shard++ {
+		for replica := int64(0); replica < c.Replicas; replica++ {
+			ep.Replicas = append(ep.Replicas, client.ReplicaEndpoint{
+				Shard:   shard,
+				Replica: replica,
+				Host:    fmt.Sprintf("chi-%s-%s-%d-%d.%s", c.Name, c.Name, shard, replica, ep.DNSName),
+			})
+		}
+	}
+	writeData(w, ep)
+}
@@ -0,0 +1,75 @@
+package fakeacm
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/tatari-tv/terraform-provider-altinitycloud/cmd/client"
+	"net"
+	"net/http"
+)
+
+// getClusterIPAllowlist - GET /cluster/{id}/allowlist
+func (s *Server) getClusterIPAllowlist(w http.ResponseWriter, r *http.Request) {
+	clusterID := r.PathValue("id")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.clusters[clusterID]; !ok {
+		writeNotFound(w, "cluster", clusterID)
+		return
+	}
+	writeData(w, append([]client.IPAllowlistEntry{}, s.allowlists[clusterID]...))
+}
+
+// setClusterIPAllowlist - POST /cluster/{id}/allowlist
+func (s *Server) setClusterIPAllowlist(w http.ResponseWriter, r *http.Request) {
+	clusterID := r.PathValue("id")
+
+	var req struct {
+		Entries *[]client.IPAllowlistEntry `json:"entries"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.Entries == nil {
+		writeError(w, http.StatusUnprocessableEntity, "validation_failed", "invalid request",
+			[]client.FieldError{{Field: "entries", Message: "is required as a JSON array of entries"}})
+		return
+	}
+	entries := *req.Entries
+	seen := map[string]bool{}
+	for _, e := range entries {
+		_, _, err := net.ParseCIDR(e.CIDR)
+		if err != nil && net.ParseIP(e.CIDR) == nil {
+			writeError(w, http.StatusUnprocessableEntity, "validation_failed", "invalid request",
+				[]client.FieldError{{Field: "entries", Message: fmt.Sprintf("%q is not an IP address or CIDR block", e.CIDR)}})
+			return
+		}
+		if seen[e.CIDR] {
+			writeError(w, http.StatusUnprocessableEntity, "validation_failed", "invalid request",
+				[]client.FieldError{{Field: "entries", Message: fmt.Sprintf("%s is listed more than once", e.CIDR)}})
+			return
+		}
+		seen[e.CIDR] = true
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.clusters[clusterID]; !ok {
+		writeNotFound(w, "cluster", clusterID)
+		return
+	}
+	s.allowlists[clusterID] = entries
+	writeData(w, append([]client.IPAllowlistEntry{}, entries...))
+}
+
+// IPAllowlist - returns the stored IP allowlist of a cluster.
+func (s *Server) IPAllowlist(clusterID string) []client.IPAllowlistEntry {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]client.IPAllowlistEntry{}, s.allowlists[clusterID]...)
+}
+
+// SetIPAllowlist - replaces the IP allowlist of a cluster, simulating a change made in the ACM UI.
+func (s *Server) SetIPAllowlist(clusterID string, entries []client.IPAllowlistEntry) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.allowlists[clusterID] = append([]client.IPAllowlistEntry{}, entries...)
+}
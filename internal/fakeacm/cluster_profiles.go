@@ -0,0 +1,149 @@
+package fakeacm
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/tatari-tv/terraform-provider-altinitycloud/cmd/client"
+	"net/http"
+	"sort"
+)
+
+// listClusterProfiles - GET /cluster/{id}/profiles
+func (s *Server) listClusterProfiles(w http.ResponseWriter, r *http.Request) {
+	clusterID := r.PathValue("id")
+
+	s.mu.Lock()
+	ps := []client.ClusterProfile{}
+	for _, p := range s.profiles {
+		if p.ClusterID == clusterID {
+			ps = append(ps, p)
+		}
+	}
+	s.mu.Unlock()
+
+	sort.Slice(ps, func(i, j int) bool { return ps[i].ID < ps[j].ID })
+	writeData(w, ps)
+}
+
+// createClusterProfile - POST /cluster/{id}/profiles
+func (s *Server) createClusterProfile(w http.ResponseWriter, r *http.Request) {
+	clusterID := r.PathValue("id")
+	if !requireParams(w, r, "name", "settings") {
+		return
+	}
+
+	p, ok := clusterProfileFromParams(w, r)
+	if !ok {
+		return
+	}
+	p.ClusterID = clusterID
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.clusters[clusterID]; !ok {
+		writeNotFound(w, "cluster", clusterID)
+		return
+	}
+	for _, other := range s.profiles {
+		if other.ClusterID == clusterID && other.Name == p.Name {
+			writeError(w, http.StatusConflict, "conflict", fmt.Sprintf("profile %s already exists", p.Name), nil)
+			return
+		}
+	}
+	p.ID = s.newID()
+	s.profiles[p.ID] = p
+	writeData(w, p)
+}
+
+// getClusterProfile - GET /clusterprofile/{id}
+func (s *Server) getClusterProfile(w http.ResponseWriter, r *http.Request) {
+	ID := r.PathValue("id")
+
+	s.mu.Lock()
+	p, ok := s.profiles[ID]
+	s.mu.Unlock()
+	if !ok {
+		writeNotFound(w, "profile", ID)
+		return
+	}
+	writeData(w, p)
+}
+
+// updateClusterProfile - POST /clusterprofile/{id}
+func (s *Server) updateClusterProfile(w http.ResponseWriter, r *http.Request) {
+	ID := r.PathValue("id")
+	if !requireParams(w, r, "name", "settings") {
+		return
+	}
+
+	p, ok := clusterProfileFromParams(w, r)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stored, ok := s.profiles[ID]
+	if !ok {
+		writeNotFound(w, "profile", ID)
+		return
+	}
+	p.ID = ID
+	p.ClusterID = stored.ClusterID
+	s.profiles[ID] = p
+	writeData(w, p)
+}
+
+// deleteClusterProfile - DELETE /clusterprofile/{id}
+func (s *Server) deleteClusterProfile(w http.ResponseWriter, r *http.Request) {
+	ID := r.PathValue("id")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p, ok := s.profiles[ID]
+	if !ok {
+		writeNotFound(w, "profile", ID)
+		return
+	}
+	delete(s.profiles, ID)
+	writeData(w, p)
+}
+
+// ClusterProfile - returns a stored cluster profile by ID.
+func (s *Server) ClusterProfile(ID string) (client.ClusterProfile, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p, ok := s.profiles[ID]
+	return p, ok
+}
+
+// SetClusterProfileSetting - changes a single setting of a stored profile, e.g. to simulate a change made in the ACM UI.
+func (s *Server) SetClusterProfileSetting(ID, name, value string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p := s.profiles[ID]
+	settings := map[string]string{}
+	for k, v := range p.Settings {
+		settings[k] = v
+	}
+	settings[name] = value
+	p.Settings = settings
+	s.profiles[ID] = p
+}
+
+// clusterProfileFromParams - builds a cluster profile from the request query params,
+// writes a validation error when the settings are not a JSON object of strings.
+func clusterProfileFromParams(w http.ResponseWriter, r *http.Request) (client.ClusterProfile, bool) {
+	q := r.URL.Query()
+	p := client.ClusterProfile{
+		Name:        q.Get("name"),
+		Description: q.Get("description"),
+		Settings:    map[string]string{},
+	}
+	if err := json.Unmarshal([]byte(q.Get("settings")), &p.Settings); err != nil {
+		writeError(w, http.StatusUnprocessableEntity, "validation_failed", "invalid request",
+			[]client.FieldError{{Field: "settings", Message: "must be a JSON object of strings"}})
+		return client.ClusterProfile{}, false
+	}
+	return p, true
+}
@@ -0,0 +1,100 @@
+package fakeacm
+
+import (
+	"encoding/json"
+	"github.com/tatari-tv/terraform-provider-altinitycloud/cmd/client"
+	"net/http"
+	"strconv"
+)
+
+// getClusterSchedule - GET /cluster/{id}/schedule
+func (s *Server) getClusterSchedule(w http.ResponseWriter, r *http.Request) {
+	clusterID := r.PathValue("id")
+
+	s.mu.Lock()
+	cs, ok := s.clusterSchedules[clusterID]
+	s.mu.Unlock()
+	if !ok {
+		writeNotFound(w, "cluster schedule", clusterID)
+		return
+	}
+	writeData(w, cs)
+}
+
+// setClusterSchedule - POST /cluster/{id}/schedule
+func (s *Server) setClusterSchedule(w http.ResponseWriter, r *http.Request) {
+	clusterID := r.PathValue("id")
+	if !requireParams(w, r, "mode") {
+		return
+	}
+
+	q := r.URL.Query()
+	cs := client.ClusterSchedule{ClusterID: clusterID, Mode: q.Get("mode")}
+	switch cs.Mode {
+	case client.ScheduleModeAlwaysOn:
+	case client.ScheduleModeStopWhenInactive:
+		if !requireParams(w, r, "inactiveHours") {
+			return
+		}
+		hours, err := strconv.ParseInt(q.Get("inactiveHours"), 10, 64)
+		if err != nil || hours < 1 {
+			writeError(w, http.StatusUnprocessableEntity, "validation_failed", "invalid request",
+				[]client.FieldError{{Field: "inactiveHours", Message: "must be a positive number"}})
+			return
+		}
+		cs.InactiveHours = hours
+	case client.ScheduleModeWeekly:
+		if !requireParams(w, r, "timezone", "days") {
+			return
+		}
+		cs.Timezone = q.Get("timezone")
+		if err := json.Unmarshal([]byte(q.Get("days")), &cs.Days); err != nil || len(cs.Days) == 0 {
+			writeError(w, http.StatusUnprocessableEntity, "validation_failed", "invalid request",
+				[]client.FieldError{{Field: "days", Message: "must list at least one day"}})
+			return
+		}
+	default:
+		writeError(w, http.StatusUnprocessableEntity, "validation_failed", "invalid request",
+			[]client.FieldError{{Field: "mode", Message: "unknown schedule mode " + cs.Mode}})
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.clusters[clusterID]; !ok {
+		writeNotFound(w, "cluster", clusterID)
+		return
+	}
+	s.clusterSchedules[clusterID] = cs
+	writeData(w, cs)
+}
+
+// deleteClusterSchedule - DELETE /cluster/{id}/schedule
+func (s *Server) deleteClusterSchedule(w http.ResponseWriter, r *http.Request) {
+	clusterID := r.PathValue("id")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	cs, ok := s.clusterSchedules[clusterID]
+	if !ok {
+		writeNotFound(w, "cluster schedule", clusterID)
+		return
+	}
+	delete(s.clusterSchedules, clusterID)
+	writeData(w, cs)
+}
+
+// ClusterSchedule - returns the stored uptime schedule of a cluster.
+func (s *Server) ClusterSchedule(clusterID string) (client.ClusterSchedule, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	cs, ok := s.clusterSchedules[clusterID]
+	return cs, ok
+}
+
+// SetClusterSchedule - replaces the uptime schedule of a cluster, simulating a change made outside Terraform.
+func (s *Server) SetClusterSchedule(cs client.ClusterSchedule) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.clusterSchedules[cs.ClusterID] = cs
+}
@@ -0,0 +1,153 @@
+package fakeacm
+
+import (
+	"fmt"
+	"github.com/tatari-tv/terraform-provider-altinitycloud/cmd/client"
+	"net/http"
+	"sort"
+)
+
+// listClusterSettings - GET /cluster/{id}/settings
+func (s *Server) listClusterSettings(w http.ResponseWriter, r *http.Request) {
+	clusterID := r.PathValue("id")
+
+	s.mu.Lock()
+	ss := []client.ClusterSetting{}
+	for _, st := range s.settings {
+		if st.ClusterID == clusterID {
+			ss = append(ss, st)
+		}
+	}
+	s.mu.Unlock()
+
+	sort.Slice(ss, func(i, j int) bool { return ss[i].ID < ss[j].ID })
+	writeData(w, ss)
+}
+
+// createClusterSetting - POST /cluster/{id}/settings
+func (s *Server) createClusterSetting(w http.ResponseWriter, r *http.Request) {
+	clusterID := r.PathValue("id")
+	if !requireParams(w, r, "name") {
+		return
+	}
+
+	st := clusterSettingFromParams(r)
+	st.ClusterID = clusterID
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.clusters[clusterID]; !ok {
+		writeNotFound(w, "cluster", clusterID)
+		return
+	}
+	for _, other := range s.settings {
+		if other.ClusterID == clusterID && other.Name == st.Name {
+			writeError(w, http.StatusConflict, "conflict", fmt.Sprintf("setting %s already exists", st.Name), nil)
+			return
+		}
+	}
+	st.ID = s.newID()
+	s.settings[st.ID] = st
+	writeData(w, st)
+}
+
+// getClusterSetting - GET /clustersetting/{id}
+func (s *Server) getClusterSetting(w http.ResponseWriter, r *http.Request) {
+	ID := r.PathValue("id")
+
+	s.mu.Lock()
+	st, ok := s.settings[ID]
+	s.mu.Unlock()
+	if !ok {
+		writeNotFound(w, "setting", ID)
+		return
+	}
+	writeData(w, st)
+}
+
+// updateClusterSetting - POST /clustersetting/{id}
+func (s *Server) updateClusterSetting(w http.ResponseWriter, r *http.Request) {
+	ID := r.PathValue("id")
+	if !requireParams(w, r, "name") {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stored, ok := s.settings[ID]
+	if !ok {
+		writeNotFound(w, "setting", ID)
+		return
+	}
+	st := clusterSettingFromParams(r)
+	st.ID = ID
+	st.ClusterID = stored.ClusterID
+	s.settings[ID] = st
+	writeData(w, st)
+}
+
+// deleteClusterSetting - DELETE /clustersetting/{id}
+func (s *Server) deleteClusterSetting(w http.ResponseWriter, r *http.Request) {
+	ID := r.PathValue("id")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	st, ok := s.settings[ID]
+	if !ok {
+		writeNotFound(w, "setting", ID)
+		return
+	}
+	delete(s.settings, ID)
+	writeData(w, st)
+}
+
+// restartCluster - POST /cluster/{id}/restart
+func (s *Server) restartCluster(w http.ResponseWriter, r *http.Request) {
+	ID := r.PathValue("id")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c, ok := s.clusters[ID]
+	if !ok {
+		writeNotFound(w, "cluster", ID)
+		return
+	}
+	s.restarts[ID]++
+	s.clusters[ID] = s.launch(c.Cluster)
+	writeData(w, s.clusters[ID].public())
+}
+
+// ClusterSetting - returns a stored cluster setting by ID.
+func (s *Server) ClusterSetting(ID string) (client.ClusterSetting, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	st, ok := s.settings[ID]
+	return st, ok
+}
+
+// SetClusterSetting - changes a stored setting value, e.g. to simulate a change made in the ACM UI.
+func (s *Server) SetClusterSetting(ID, value string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	st := s.settings[ID]
+	st.Value = value
+	s.settings[ID] = st
+}
+
+// Restarts - returns how many times the cluster was restarted.
+func (s *Server) Restarts(clusterID string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.restarts[clusterID]
+}
+
+// clusterSettingFromParams - builds a cluster setting from the request query params.
+func clusterSettingFromParams(r *http.Request) client.ClusterSetting {
+	q := r.URL.Query()
+	return client.ClusterSetting{
+		Name:            q.Get("name"),
+		Value:           q.Get("value"),
+		FileContent:     q.Get("file"),
+		RestartRequired: q.Get("restartRequired") == "true",
+	}
+}
@@ -0,0 +1,146 @@
+package fakeacm
+
+import (
+	"fmt"
+	"github.com/tatari-tv/terraform-provider-altinitycloud/cmd/client"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// listClusterUsers - GET /cluster/{id}/users
+func (s *Server) listClusterUsers(w http.ResponseWriter, r *http.Request) {
+	clusterID := r.PathValue("id")
+
+	s.mu.Lock()
+	us := []client.ClusterUser{}
+	for _, u := range s.users {
+		if u.ClusterID == clusterID {
+			us = append(us, publicUser(u))
+		}
+	}
+	s.mu.Unlock()
+
+	sort.Slice(us, func(i, j int) bool { return us[i].ID < us[j].ID })
+	writeData(w, us)
+}
+
+// createClusterUser - POST /cluster/{id}/users
+func (s *Server) createClusterUser(w http.ResponseWriter, r *http.Request) {
+	clusterID := r.PathValue("id")
+	if !requireParams(w, r, "name", "password") {
+		return
+	}
+
+	u := client.ClusterUser{
+		ClusterID: clusterID,
+		Profile:   "default",
+		Quota:     "default",
+		Networks:  []string{"::/0"},
+		Databases: []string{},
+	}
+	applyClusterUserParams(r, &u)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.clusters[clusterID]; !ok {
+		writeNotFound(w, "cluster", clusterID)
+		return
+	}
+	for _, other := range s.users {
+		if other.ClusterID == clusterID && other.Name == u.Name {
+			writeError(w, http.StatusConflict, "conflict", fmt.Sprintf("user %s already exists", u.Name), nil)
+			return
+		}
+	}
+	u.ID = s.newID()
+	s.users[u.ID] = u
+	writeData(w, publicUser(u))
+}
+
+// getClusterUser - GET /clusteruser/{id}
+func (s *Server) getClusterUser(w http.ResponseWriter, r *http.Request) {
+	ID := r.PathValue("id")
+
+	s.mu.Lock()
+	u, ok := s.users[ID]
+	s.mu.Unlock()
+	if !ok {
+		writeNotFound(w, "user", ID)
+		return
+	}
+	writeData(w, publicUser(u))
+}
+
+// updateClusterUser - POST /clusteruser/{id}
+func (s *Server) updateClusterUser(w http.ResponseWriter, r *http.Request) {
+	ID := r.PathValue("id")
+	if !requireParams(w, r, "name") {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	u, ok := s.users[ID]
+	if !ok {
+		writeNotFound(w, "user", ID)
+		return
+	}
+	applyClusterUserParams(r, &u)
+	s.users[ID] = u
+	writeData(w, publicUser(u))
+}
+
+// deleteClusterUser - DELETE /clusteruser/{id}
+func (s *Server) deleteClusterUser(w http.ResponseWriter, r *http.Request) {
+	ID := r.PathValue("id")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	u, ok := s.users[ID]
+	if !ok {
+		writeNotFound(w, "user", ID)
+		return
+	}
+	delete(s.users, ID)
+	writeData(w, publicUser(u))
+}
+
+// ClusterUser - returns the stored cluster user by ID, including its password.
+func (s *Server) ClusterUser(ID string) (client.ClusterUser, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	u, ok := s.users[ID]
+	return u, ok
+}
+
+// applyClusterUserParams - applies the cluster user attributes from the request query and form params.
+func applyClusterUserParams(r *http.Request, u *client.ClusterUser) {
+	_ = r.ParseForm()
+	q := r.Form
+	u.Name = q.Get("name")
+	if v := q.Get("password"); v != "" {
+		u.Password = v
+	}
+	if v := q.Get("profile"); v != "" {
+		u.Profile = v
+	}
+	if v := q.Get("quota"); v != "" {
+		u.Quota = v
+	}
+	if v := q.Get("networks"); v != "" {
+		u.Networks = strings.Split(v, ",")
+	}
+	if q.Has("databases") {
+		u.Databases = []string{}
+		if v := q.Get("databases"); v != "" {
+			u.Databases = strings.Split(v, ",")
+		}
+	}
+}
+
+// publicUser - returns the user as the API reports it, the password is never returned.
+func publicUser(u client.ClusterUser) client.ClusterUser {
+	u.Password = ""
+	return u
+}
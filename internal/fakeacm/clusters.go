@@ -0,0 +1,210 @@
+package fakeacm
+
+import (
+	"fmt"
+	"github.com/tatari-tv/terraform-provider-altinitycloud/cmd/client"
+	"net/http"
+	"sort"
+	"strconv"
+)
+
+// listClusters - GET /environment/{id}/clusters
+func (s *Server) listClusters(w http.ResponseWriter, r *http.Request) {
+	envID := r.PathValue("id")
+
+	s.mu.Lock()
+	cs := []client.Cluster{}
+	for _, c := range s.clusters {
+		if c.EnvID == envID {
+			cs = append(cs, c.public())
+		}
+	}
+	s.mu.Unlock()
+
+	sort.Slice(cs, func(i, j int) bool { return cs[i].ID < cs[j].ID })
+	writeData(w, cs)
+}
+
+// createCluster - POST /environment/{id}/clusters
+func (s *Server) createCluster(w http.ResponseWriter, r *http.Request) {
+	envID := r.PathValue("id")
+	if !requireParams(w, r, "name", "version", "nodeType", "size") {
+		return
+	}
+
+	c, fields := clusterFromParams(r)
+	if len(fields) > 0 {
+		writeError(w, http.StatusUnprocessableEntity, "validation_failed", "invalid request", fields)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, other := range s.clusters {
+		if other.EnvID == envID && other.Name == c.Name {
+			writeError(w, http.StatusConflict, "conflict", fmt.Sprintf("cluster %s already exists", c.Name), nil)
+			return
+		}
+	}
+	c.ID = s.newID()
+	c.EnvID = envID
+	s.clusters[c.ID] = s.launch(c)
+	writeData(w, s.clusters[c.ID].public())
+}
+
+// getCluster - GET /cluster/{id}
+func (s *Server) getCluster(w http.ResponseWriter, r *http.Request) {
+	ID := r.PathValue("id")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c, ok := s.clusters[ID]
+	if !ok {
+		writeNotFound(w, "cluster", ID)
+		return
+	}
+
+	// every status read brings a launching, rescaling or upgrading cluster closer to online
+	if c.Status == client.ClusterStatusLaunching || c.Status == client.ClusterStatusRescaling || c.Status == client.ClusterStatusUpgrading {
+		if c.launchPolls > 0 {
+			c.launchPolls--
+		} else {
+			c.Status = client.ClusterStatusOnline
+		}
+		s.clusters[ID] = c
+	}
+	writeData(w, c.public())
+}
+
+// updateCluster - POST /cluster/{id}
+func (s *Server) updateCluster(w http.ResponseWriter, r *http.Request) {
+	ID := r.PathValue("id")
+
+	c, fields := clusterFromParams(r)
+	if len(fields) > 0 {
+		writeError(w, http.StatusUnprocessableEntity, "validation_failed", "invalid request", fields)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stored, ok := s.clusters[ID]
+	if !ok {
+		writeNotFound(w, "cluster", ID)
+		return
+	}
+	c.ID = ID
+	c.EnvID = stored.EnvID
+	if c.AdminPassword == "" {
+		c.AdminPassword = stored.AdminPassword
+	}
+	s.clusters[ID] = s.launch(c)
+	writeData(w, s.clusters[ID].public())
+}
+
+// rescaleCluster - POST /cluster/{id}/rescale
+func (s *Server) rescaleCluster(w http.ResponseWriter, r *http.Request) {
+	ID := r.PathValue("id")
+	if !requireParams(w, r, "nodeType", "shards", "replicas", "size") {
+		return
+	}
+
+	scaled, fields := clusterFromParams(r)
+	if len(fields) > 0 {
+		writeError(w, http.StatusUnprocessableEntity, "validation_failed", "invalid request", fields)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c, ok := s.clusters[ID]
+	if !ok {
+		writeNotFound(w, "cluster", ID)
+		return
+	}
+	if scaled.DiskSize < c.DiskSize {
+		writeError(w, http.StatusUnprocessableEntity, "validation_failed", "invalid request",
+			[]client.FieldError{{Field: "size", Message: fmt.Sprintf("cannot be reduced from %d", c.DiskSize)}})
+		return
+	}
+
+	c.NodeType = scaled.NodeType
+	c.Shards = scaled.Shards
+	c.Replicas = scaled.Replicas
+	c.DiskSize = scaled.DiskSize
+	c.Status = client.ClusterStatusRescaling
+	c.launchPolls = s.ClusterLaunchPolls
+	s.clusters[ID] = c
+	s.rescales[ID]++
+	writeData(w, c.public())
+}
+
+// deleteCluster - DELETE /cluster/{id}
+func (s *Server) deleteCluster(w http.ResponseWriter, r *http.Request) {
+	ID := r.PathValue("id")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c, ok := s.clusters[ID]
+	if !ok {
+		writeNotFound(w, "cluster", ID)
+		return
+	}
+	delete(s.clusters, ID)
+	writeData(w, c.public())
+}
+
+// launch - marks the cluster as launching, callers hold the lock.
+func (s *Server) launch(c client.Cluster) cluster {
+	c.Status = client.ClusterStatusLaunching
+	return cluster{Cluster: c, launchPolls: s.ClusterLaunchPolls}
+}
+
+// clusterFromParams - builds a cluster from the request query and form params the way the API stores it.
+func clusterFromParams(r *http.Request) (client.Cluster, []client.FieldError) {
+	_ = r.ParseForm()
+	q := r.Form
+	c := client.Cluster{
+		Name:          q.Get("name"),
+		Version:       q.Get("version"),
+		NodeType:      q.Get("nodeType"),
+		Zookeeper:     q.Get("zookeeper"),
+		AdminUser:     q.Get("adminUser"),
+		AdminPassword: q.Get("adminPass"),
+		Shards:        1,
+		Replicas:      1,
+	}
+
+	var fields []client.FieldError
+	for _, p := range []struct {
+		name string
+		dst  *int64
+	}{{"shards", &c.Shards}, {"replicas", &c.Replicas}, {"size", &c.DiskSize}} {
+		v := q.Get(p.name)
+		if v == "" {
+			continue
+		}
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err != nil || n < 1 {
+			fields = append(fields, client.FieldError{Field: p.name, Message: "must be a positive number"})
+			continue
+		}
+		*p.dst = n
+	}
+
+	return c, fields
+}
+
+// Rescales - returns how many times the cluster was rescaled.
+func (s *Server) Rescales(clusterID string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.rescales[clusterID]
+}
+
+// public - returns the cluster as the API reports it, the admin password is never returned.
+func (c cluster) public() client.Cluster {
+	pc := c.Cluster
+	pc.AdminPassword = ""
+	return pc
+}
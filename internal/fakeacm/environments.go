@@ -0,0 +1,115 @@
+package fakeacm
+
+import (
+	"fmt"
+	"github.com/tatari-tv/terraform-provider-altinitycloud/cmd/client"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// listEnvironments - GET /environments
+func (s *Server) listEnvironments(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	envs := []client.Environment{}
+	for _, env := range s.environments {
+		envs = append(envs, env)
+	}
+	s.mu.Unlock()
+
+	sort.Slice(envs, func(i, j int) bool { return envs[i].ID < envs[j].ID })
+	writeData(w, envs)
+}
+
+// createEnvironment - POST /environments
+func (s *Server) createEnvironment(w http.ResponseWriter, r *http.Request) {
+	if !requireParams(w, r, "name", "cloud", "region") {
+		return
+	}
+
+	q := r.URL.Query()
+	env := client.Environment{
+		Cloud:               q.Get("cloud"),
+		Region:              q.Get("region"),
+		Zones:               []string{q.Get("region") + "a"},
+		KubernetesVersion:   "1.29",
+		KubernetesNamespace: "altinity-cloud-managed-clickhouse",
+		Limits:              client.EnvironmentLimits{Clusters: 10, Shards: 8, Replicas: 3, Nodes: 48, DiskSize: 10000},
+	}
+	applyEnvironmentParams(r, &env)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, other := range s.environments {
+		if other.Name == env.Name {
+			writeError(w, http.StatusConflict, "conflict", fmt.Sprintf("environment %s already exists", env.Name), nil)
+			return
+		}
+	}
+	env.ID = s.newID()
+	s.environments[env.ID] = env
+	writeData(w, env)
+}
+
+// getEnvironment - GET /environment/{id}
+func (s *Server) getEnvironment(w http.ResponseWriter, r *http.Request) {
+	ID := r.PathValue("id")
+
+	s.mu.Lock()
+	env, ok := s.environments[ID]
+	s.mu.Unlock()
+	if !ok {
+		writeNotFound(w, "environment", ID)
+		return
+	}
+	writeData(w, env)
+}
+
+// updateEnvironment - POST /environment/{id}
+func (s *Server) updateEnvironment(w http.ResponseWriter, r *http.Request) {
+	ID := r.PathValue("id")
+	if !requireParams(w, r, "name") {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	env, ok := s.environments[ID]
+	if !ok {
+		writeNotFound(w, "environment", ID)
+		return
+	}
+	applyEnvironmentParams(r, &env)
+	s.environments[ID] = env
+	writeData(w, env)
+}
+
+// deleteEnvironment - DELETE /environment/{id}
+func (s *Server) deleteEnvironment(w http.ResponseWriter, r *http.Request) {
+	ID := r.PathValue("id")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	env, ok := s.environments[ID]
+	if !ok {
+		writeNotFound(w, "environment", ID)
+		return
+	}
+	delete(s.environments, ID)
+	writeData(w, env)
+}
+
+// applyEnvironmentParams - applies the updatable environment attributes from the request query params.
+func applyEnvironmentParams(r *http.Request, env *client.Environment) {
+	q := r.URL.Query()
+	env.Name = q.Get("name")
+	if v := q.Get("zones"); v != "" {
+		env.Zones = strings.Split(v, ",")
+	}
+	if v := q.Get("nodeTypeClickHouse"); v != "" {
+		env.DefaultClickHouseNodeType = v
+	}
+	if v := q.Get("nodeTypeZookeeper"); v != "" {
+		env.DefaultZookeeperNodeType = v
+	}
+}
@@ -0,0 +1,149 @@
+package fakeacm
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/tatari-tv/terraform-provider-altinitycloud/cmd/client"
+	"net/http"
+	"sort"
+)
+
+// listNodeTypes - GET /environment/{id}/nodetypes
+func (s *Server) listNodeTypes(w http.ResponseWriter, r *http.Request) {
+	envID := r.PathValue("id")
+
+	s.mu.Lock()
+	nts := []client.NodeType{}
+	for _, nt := range s.nodeTypes {
+		if nt.envID == envID {
+			nts = append(nts, nt.NodeType)
+		}
+	}
+	s.mu.Unlock()
+
+	sort.Slice(nts, func(i, j int) bool { return nts[i].ID < nts[j].ID })
+	writeData(w, nts)
+}
+
+// createNodeType - POST /environment/{id}/nodetypes
+func (s *Server) createNodeType(w http.ResponseWriter, r *http.Request) {
+	envID := r.PathValue("id")
+	if !requireParams(w, r, "name", "scope", "cpu", "memory") {
+		return
+	}
+
+	nt, err := nodeTypeFromParams(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "bad_request", err.Error(), nil)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.nodeTypeNameTaken(envID, nt.Name, "") {
+		writeError(w, http.StatusConflict, "conflict", fmt.Sprintf("node type %s already exists", nt.Name), nil)
+		return
+	}
+	nt.ID = s.newID()
+	s.nodeTypes[nt.ID] = nodeType{NodeType: nt, envID: envID}
+	writeData(w, nt)
+}
+
+// getNodeType - GET /nodetype/{id}
+func (s *Server) getNodeType(w http.ResponseWriter, r *http.Request) {
+	ID := r.PathValue("id")
+
+	s.mu.Lock()
+	nt, ok := s.nodeTypes[ID]
+	s.mu.Unlock()
+	if !ok {
+		writeNotFound(w, "node type", ID)
+		return
+	}
+	writeData(w, nt.NodeType)
+}
+
+// updateNodeType - POST /nodetype/{id}
+func (s *Server) updateNodeType(w http.ResponseWriter, r *http.Request) {
+	ID := r.PathValue("id")
+	if !requireParams(w, r, "name", "scope", "cpu", "memory") {
+		return
+	}
+
+	nt, err := nodeTypeFromParams(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "bad_request", err.Error(), nil)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stored, ok := s.nodeTypes[ID]
+	if !ok {
+		writeNotFound(w, "node type", ID)
+		return
+	}
+	if s.nodeTypeNameTaken(stored.envID, nt.Name, ID) {
+		writeError(w, http.StatusConflict, "conflict", fmt.Sprintf("node type %s already exists", nt.Name), nil)
+		return
+	}
+	nt.ID = ID
+	s.nodeTypes[ID] = nodeType{NodeType: nt, envID: stored.envID}
+	writeData(w, nt)
+}
+
+// deleteNodeType - DELETE /nodetype/{id}
+func (s *Server) deleteNodeType(w http.ResponseWriter, r *http.Request) {
+	ID := r.PathValue("id")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	nt, ok := s.nodeTypes[ID]
+	if !ok {
+		writeNotFound(w, "node type", ID)
+		return
+	}
+	delete(s.nodeTypes, ID)
+	writeData(w, nt.NodeType)
+}
+
+// nodeTypeNameTaken - returns true if another node type in the environment has the name, callers hold the lock.
+func (s *Server) nodeTypeNameTaken(envID, name, exceptID string) bool {
+	for ID, nt := range s.nodeTypes {
+		if nt.envID == envID && nt.Name == name && ID != exceptID {
+			return true
+		}
+	}
+	return false
+}
+
+// nodeTypeFromParams - builds a node type from the request query params the way the API stores it.
+func nodeTypeFromParams(r *http.Request) (client.NodeType, error) {
+	q := r.URL.Query()
+	nt := client.NodeType{
+		Name:         q.Get("name"),
+		Scope:        q.Get("scope"),
+		Code:         q.Get("code"),
+		Pool:         q.Get("pool"),
+		StorageClass: q.Get("storageClass"),
+		CPU:          q.Get("cpu"),
+		Memory:       q.Get("memory"),
+		ExtraSpec:    client.JSONString(q.Get("extraSpec")),
+		// the API reports the whole node as allocatable
+		CPUAlloc:    q.Get("cpu"),
+		MemoryAlloc: q.Get("memory"),
+	}
+
+	if v := q.Get("nodeSelector"); v != "" {
+		if err := json.Unmarshal([]byte(v), &nt.NodeSelector); err != nil {
+			return client.NodeType{}, fmt.Errorf("invalid nodeSelector: %w", err)
+		}
+	}
+	if v := q.Get("tolerations"); v != "" {
+		if err := json.Unmarshal([]byte(v), &nt.Tolerations); err != nil {
+			return client.NodeType{}, fmt.Errorf("invalid tolerations: %w", err)
+		}
+	}
+
+	return nt, nil
+}
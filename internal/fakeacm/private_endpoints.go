@@ -0,0 +1,261 @@
+package fakeacm
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/tatari-tv/terraform-provider-altinitycloud/cmd/client"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// privateEndpointService - private endpoint service stored together with the number of status reads left until it is available.
+type privateEndpointService struct {
+	client.PrivateEndpointService
+	polls int
+}
+
+// privateEndpointConnection - connection stored together with the number of status reads left until it is approved.
+type privateEndpointConnection struct {
+	client.PrivateEndpointConnection
+	polls int
+}
+
+// getPrivateEndpointService - GET /environment/{id}/privateendpoint
+func (s *Server) getPrivateEndpointService(w http.ResponseWriter, r *http.Request) {
+	envID := r.PathValue("id")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ps, ok := s.endpointServices[envID]
+	if !ok {
+		writeNotFound(w, "private endpoint service", envID)
+		return
+	}
+
+	// every status read brings a provisioning service closer to available
+	if ps.Status == client.PrivateEndpointStatusProvisioning {
+		if ps.polls > 0 {
+			ps.polls--
+		} else {
+			ps.Status = client.PrivateEndpointStatusAvailable
+		}
+		s.endpointServices[envID] = ps
+	}
+	writeData(w, ps.PrivateEndpointService)
+}
+
+// setPrivateEndpointService - POST /environment/{id}/privateendpoint
+func (s *Server) setPrivateEndpointService(w http.ResponseWriter, r *http.Request) {
+	envID := r.PathValue("id")
+	if !requireParams(w, r, "allowedPrincipals") {
+		return
+	}
+
+	var principals []string
+	if err := json.Unmarshal([]byte(r.URL.Query().Get("allowedPrincipals")), &principals); err != nil {
+		writeError(w, http.StatusUnprocessableEntity, "validation_failed", "invalid request",
+			[]client.FieldError{{Field: "allowedPrincipals", Message: "must be a JSON array of principals"}})
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	env, ok := s.environments[envID]
+	if !ok {
+		writeNotFound(w, "environment", envID)
+		return
+	}
+	for _, p := range principals {
+		if env.Cloud == "aws" && !strings.HasPrefix(p, "arn:aws:") {
+			writeError(w, http.StatusUnprocessableEntity, "validation_failed", "invalid request",
+				[]client.FieldError{{Field: "allowedPrincipals", Message: fmt.Sprintf("%q is not an AWS principal ARN", p)}})
+			return
+		}
+	}
+
+	// the service name is kept when only the allowed principals change
+	ps, ok := s.endpointServices[envID]
+	if !ok {
+		ps = privateEndpointService{
+			PrivateEndpointService: client.PrivateEndpointService{
+				EnvID:   envID,
+				Cloud:   env.Cloud,
+				DNSName: strings.ToLower(env.Name) + ".privatelink.altinity.cloud",
+				Status:  client.PrivateEndpointStatusProvisioning,
+			},
+			polls: s.PrivateEndpointPolls,
+		}
+		switch env.Cloud {
+		case "aws":
+			ps.ServiceName = fmt.Sprintf("com.amazonaws.vpce.%s.vpce-svc-%s", env.Region, privateEndpointSuffix(envID))
+		case "gcp":
+			ps.ServiceName = fmt.Sprintf("projects/altinity-cloud/regions/%s/serviceAttachments/env-%s", env.Region, envID)
+		default:
+			writeError(w, http.StatusUnprocessableEntity, "validation_failed", "invalid request",
+				[]client.FieldError{{Field: "environment", Message: "private endpoints are only supported in aws and gcp environments"}})
+			return
+		}
+	}
+	ps.AllowedPrincipals = principals
+	s.endpointServices[envID] = ps
+	writeData(w, ps.PrivateEndpointService)
+}
+
+// deletePrivateEndpointService - DELETE /environment/{id}/privateendpoint
+func (s *Server) deletePrivateEndpointService(w http.ResponseWriter, r *http.Request) {
+	envID := r.PathValue("id")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ps, ok := s.endpointServices[envID]
+	if !ok {
+		writeNotFound(w, "private endpoint service", envID)
+		return
+	}
+	for _, pc := range s.endpointConnections {
+		if pc.EnvID == envID {
+			writeError(w, http.StatusConflict, "conflict", fmt.Sprintf("private endpoint service still has connection %s", pc.ID), nil)
+			return
+		}
+	}
+	delete(s.endpointServices, envID)
+	writeData(w, ps.PrivateEndpointService)
+}
+
+// createPrivateEndpointConnection - POST /environment/{id}/privateendpoint/connections
+func (s *Server) createPrivateEndpointConnection(w http.ResponseWriter, r *http.Request) {
+	envID := r.PathValue("id")
+	if !requireParams(w, r, "endpointId") {
+		return
+	}
+	q := r.URL.Query()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ps, ok := s.endpointServices[envID]
+	if !ok {
+		writeNotFound(w, "private endpoint service", envID)
+		return
+	}
+	if ps.Status != client.PrivateEndpointStatusAvailable {
+		writeError(w, http.StatusConflict, "conflict", "private endpoint service is "+ps.Status, nil)
+		return
+	}
+	if ps.Cloud == "aws" && !strings.HasPrefix(q.Get("endpointId"), "vpce-") {
+		writeError(w, http.StatusUnprocessableEntity, "validation_failed", "invalid request",
+			[]client.FieldError{{Field: "endpointId", Message: "must be an AWS VPC endpoint ID"}})
+		return
+	}
+	for _, other := range s.endpointConnections {
+		if other.EnvID == envID && other.EndpointID == q.Get("endpointId") {
+			writeError(w, http.StatusConflict, "conflict", fmt.Sprintf("endpoint %s is already connected", other.EndpointID), nil)
+			return
+		}
+	}
+
+	pc := client.PrivateEndpointConnection{
+		ID:          s.newID(),
+		EnvID:       envID,
+		EndpointID:  q.Get("endpointId"),
+		Description: q.Get("description"),
+		Status:      client.PrivateEndpointStatusPending,
+	}
+	s.endpointConnections[pc.ID] = privateEndpointConnection{PrivateEndpointConnection: pc, polls: s.PrivateEndpointPolls}
+	writeData(w, pc)
+}
+
+// getPrivateEndpointConnection - GET /privateendpointconnection/{id}
+func (s *Server) getPrivateEndpointConnection(w http.ResponseWriter, r *http.Request) {
+	ID := r.PathValue("id")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	pc, ok := s.endpointConnections[ID]
+	if !ok {
+		writeNotFound(w, "private endpoint connection", ID)
+		return
+	}
+
+	// every status read brings a pending connection closer to approval
+	if pc.Status == client.PrivateEndpointStatusPending {
+		switch {
+		case pc.polls > 0:
+			pc.polls--
+		case pc.EndpointID == s.RejectedEndpoint:
+			pc.Status = client.PrivateEndpointStatusRejected
+			pc.Error = "endpoint is not owned by an allowed principal"
+		default:
+			pc.Status = client.PrivateEndpointStatusAvailable
+		}
+		s.endpointConnections[ID] = pc
+	}
+	writeData(w, pc.PrivateEndpointConnection)
+}
+
+// updatePrivateEndpointConnection - POST /privateendpointconnection/{id}
+func (s *Server) updatePrivateEndpointConnection(w http.ResponseWriter, r *http.Request) {
+	ID := r.PathValue("id")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	pc, ok := s.endpointConnections[ID]
+	if !ok {
+		writeNotFound(w, "private endpoint connection", ID)
+		return
+	}
+	pc.Description = r.URL.Query().Get("description")
+	s.endpointConnections[ID] = pc
+	writeData(w, pc.PrivateEndpointConnection)
+}
+
+// deletePrivateEndpointConnection - DELETE /privateendpointconnection/{id}
+func (s *Server) deletePrivateEndpointConnection(w http.ResponseWriter, r *http.Request) {
+	ID := r.PathValue("id")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	pc, ok := s.endpointConnections[ID]
+	if !ok {
+		writeNotFound(w, "private endpoint connection", ID)
+		return
+	}
+	delete(s.endpointConnections, ID)
+	writeData(w, pc.PrivateEndpointConnection)
+}
+
+// PrivateEndpointService - returns the stored private endpoint service of an environment.
+func (s *Server) PrivateEndpointService(envID string) (client.PrivateEndpointService, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ps, ok := s.endpointServices[envID]
+	return ps.PrivateEndpointService, ok
+}
+
+// PrivateEndpointConnections - returns the number of stored connections of an environment.
+func (s *Server) PrivateEndpointConnections(envID string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	n := 0
+	for _, pc := range s.endpointConnections {
+		if pc.EnvID == envID {
+			n++
+		}
+	}
+	return n
+}
+
+// RejectPrivateEndpointConnection - rejects a connection, simulating a change made in the ACM UI.
+func (s *Server) RejectPrivateEndpointConnection(ID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	pc := s.endpointConnections[ID]
+	pc.Status = client.PrivateEndpointStatusRejected
+	s.endpointConnections[ID] = pc
+}
+
+// privateEndpointSuffix - returns a stable hex suffix for generated service names.
+func privateEndpointSuffix(ID string) string {
+	n, _ := strconv.Atoi(ID)
+	return fmt.Sprintf("%017x", n)
+}
@@ -0,0 +1,86 @@
+package fakeacm
+
+import (
+	"github.com/tatari-tv/terraform-provider-altinitycloud/cmd/client"
+	"net/http"
+)
+
+// restore - restore operation stored together with the number of status reads left until it finishes.
+type restore struct {
+	client.Restore
+	polls int
+}
+
+// startRestore - POST /cluster/{id}/restore
+func (s *Server) startRestore(w http.ResponseWriter, r *http.Request) {
+	clusterID := r.PathValue("id")
+	if !requireParams(w, r, "backup") {
+		return
+	}
+	backupID := r.URL.Query().Get("backup")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.clusters[clusterID]; !ok {
+		writeNotFound(w, "cluster", clusterID)
+		return
+	}
+	b, ok := s.backups[backupID]
+	if !ok || b.Status != client.BackupStatusCompleted {
+		writeError(w, http.StatusUnprocessableEntity, "validation_failed", "invalid request",
+			[]client.FieldError{{Field: "backup", Message: "must be a completed backup"}})
+		return
+	}
+
+	rs := client.Restore{
+		ID:        s.newID(),
+		ClusterID: clusterID,
+		BackupID:  backupID,
+		Status:    client.RestoreStatusInProgress,
+	}
+	s.restores[rs.ID] = restore{Restore: rs, polls: s.RestorePolls}
+	writeData(w, rs)
+}
+
+// getRestore - GET /restore/{id}
+func (s *Server) getRestore(w http.ResponseWriter, r *http.Request) {
+	ID := r.PathValue("id")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rs, ok := s.restores[ID]
+	if !ok {
+		writeNotFound(w, "restore", ID)
+		return
+	}
+
+	// every status read brings a running restore closer to the end
+	if rs.Status == client.RestoreStatusInProgress {
+		switch {
+		case rs.polls > 0:
+			rs.polls--
+			rs.Progress = 100 / int64(rs.polls+2)
+		case s.RestoreError != "":
+			rs.Status = client.RestoreStatusFailed
+			rs.Error = s.RestoreError
+		default:
+			rs.Status = client.RestoreStatusCompleted
+			rs.Progress = 100
+		}
+		s.restores[ID] = rs
+	}
+	writeData(w, rs.Restore)
+}
+
+// Restores - returns the restore operations of a cluster.
+func (s *Server) Restores(clusterID string) []client.Restore {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rs := []client.Restore{}
+	for _, r := range s.restores {
+		if r.ClusterID == clusterID {
+			rs = append(rs, r.Restore)
+		}
+	}
+	return rs
+}
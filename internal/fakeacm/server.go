@@ -0,0 +1,333 @@
+// Package fakeacm implements an in-memory fake of the Altinity.Cloud (ACM) API for tests.
+// It keeps node types, clusters with their users, settings, profiles and backups, and environments
+// with their private endpoints in memory, so acceptance tests can run the provider against it without touching real infrastructure,
+// and supports injecting latency and error responses to exercise retries and error handling.
+package fakeacm
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/tatari-tv/terraform-provider-altinitycloud/cmd/client"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// Token - API token accepted by the fake server.
+const Token = "fake-acm-token"
+
+// Fault - error or latency injected into matching requests.
+type Fault struct {
+	// Method - HTTP method to match, empty matches any method.
+	Method string
+	// Path - request path prefix to match, e.g. "/nodetype/", empty matches any path.
+	Path string
+	// Status - HTTP status to respond with, zero lets the request through after Latency.
+	Status int
+	// Latency - delay before the request is handled.
+	Latency time.Duration
+	// Times - number of requests the fault applies to, zero applies it to every request.
+	Times int
+}
+
+// Server - fake Altinity.Cloud API server.
+type Server struct {
+	*httptest.Server
+
+	// ClusterLaunchPolls - number of status reads a new, updated, rescaled or upgraded cluster
+	// reports as launching, rescaling or upgrading before it comes online.
+	ClusterLaunchPolls int
+	// BackupPolls - number of status reads a new backup reports as in progress before it completes.
+	BackupPolls int
+	// RestorePolls - number of status reads a restore reports as in progress before it finishes.
+	RestorePolls int
+	// RestoreError - when set, restores fail with this error instead of completing.
+	RestoreError string
+	// PrivateEndpointPolls - number of status reads a new private endpoint service reports as provisioning,
+	// and a new connection reports as pending, before it becomes available.
+	PrivateEndpointPolls int
+	// RejectedEndpoint - when set, connections from this endpoint ID are rejected instead of approved.
+	RejectedEndpoint string
+	// ClickHouseVersions - ClickHouse versions offered to every environment, clusters can only be upgraded to these.
+	ClickHouseVersions []client.ClickHouseVersion
+
+	mu                  sync.Mutex
+	nextID              int
+	nodeTypes           map[string]nodeType
+	clusters            map[string]cluster
+	environments        map[string]client.Environment
+	users               map[string]client.ClusterUser
+	settings            map[string]client.ClusterSetting
+	profiles            map[string]client.ClusterProfile
+	backupSchedules     map[string]client.BackupSchedule
+	clusterSchedules    map[string]client.ClusterSchedule
+	allowlists          map[string][]client.IPAllowlistEntry
+	endpointServices    map[string]privateEndpointService
+	endpointConnections map[string]privateEndpointConnection
+	backups             map[string]backup
+	restores            map[string]restore
+	restarts            map[string]int
+	rescales            map[string]int
+	faults              []*Fault
+	requests            []string
+}
+
+// nodeType - node type stored together with its environment.
+type nodeType struct {
+	client.NodeType
+	envID string
+}
+
+// cluster - cluster stored together with the number of polls left until it is online.
+type cluster struct {
+	client.Cluster
+	launchPolls int
+}
+
+// NewServer - starts a fake server that is closed when the test finishes.
+func NewServer(t testing.TB) *Server {
+	s := &Server{
+		nextID:              1000,
+		ClickHouseVersions:  append([]client.ClickHouseVersion{}, DefaultClickHouseVersions...),
+		nodeTypes:           map[string]nodeType{},
+		clusters:            map[string]cluster{},
+		environments:        map[string]client.Environment{},
+		users:               map[string]client.ClusterUser{},
+		settings:            map[string]client.ClusterSetting{},
+		profiles:            map[string]client.ClusterProfile{},
+		backupSchedules:     map[string]client.BackupSchedule{},
+		clusterSchedules:    map[string]client.ClusterSchedule{},
+		allowlists:          map[string][]client.IPAllowlistEntry{},
+		endpointServices:    map[string]privateEndpointService{},
+		endpointConnections: map[string]privateEndpointConnection{},
+		backups:             map[string]backup{},
+		restores:            map[string]restore{},
+		restarts:            map[string]int{},
+		rescales:            map[string]int{},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /environments", s.listEnvironments)
+	mux.HandleFunc("POST /environments", s.createEnvironment)
+	mux.HandleFunc("GET /environment/{id}", s.getEnvironment)
+	mux.HandleFunc("POST /environment/{id}", s.updateEnvironment)
+	mux.HandleFunc("DELETE /environment/{id}", s.deleteEnvironment)
+	mux.HandleFunc("GET /environment/{id}/versions", s.listClickHouseVersions)
+	mux.HandleFunc("GET /environment/{id}/nodetypes", s.listNodeTypes)
+	mux.HandleFunc("POST /environment/{id}/nodetypes", s.createNodeType)
+	mux.HandleFunc("GET /nodetype/{id}", s.getNodeType)
+	mux.HandleFunc("POST /nodetype/{id}", s.updateNodeType)
+	mux.HandleFunc("DELETE /nodetype/{id}", s.deleteNodeType)
+	mux.HandleFunc("GET /environment/{id}/clusters", s.listClusters)
+	mux.HandleFunc("POST /environment/{id}/clusters", s.createCluster)
+	mux.HandleFunc("GET /cluster/{id}", s.getCluster)
+	mux.HandleFunc("POST /cluster/{id}", s.updateCluster)
+	mux.HandleFunc("DELETE /cluster/{id}", s.deleteCluster)
+	mux.HandleFunc("POST /cluster/{id}/rescale", s.rescaleCluster)
+	mux.HandleFunc("POST /cluster/{id}/upgrade", s.upgradeCluster)
+	mux.HandleFunc("GET /cluster/{id}/users", s.listClusterUsers)
+	mux.HandleFunc("POST /cluster/{id}/users", s.createClusterUser)
+	mux.HandleFunc("GET /clusteruser/{id}", s.getClusterUser)
+	mux.HandleFunc("POST /clusteruser/{id}", s.updateClusterUser)
+	mux.HandleFunc("DELETE /clusteruser/{id}", s.deleteClusterUser)
+	mux.HandleFunc("GET /cluster/{id}/settings", s.listClusterSettings)
+	mux.HandleFunc("POST /cluster/{id}/settings", s.createClusterSetting)
+	mux.HandleFunc("GET /clustersetting/{id}", s.getClusterSetting)
+	mux.HandleFunc("POST /clustersetting/{id}", s.updateClusterSetting)
+	mux.HandleFunc("DELETE /clustersetting/{id}", s.deleteClusterSetting)
+	mux.HandleFunc("POST /cluster/{id}/restart", s.restartCluster)
+	mux.HandleFunc("GET /cluster/{id}/profiles", s.listClusterProfiles)
+	mux.HandleFunc("POST /cluster/{id}/profiles", s.createClusterProfile)
+	mux.HandleFunc("GET /clusterprofile/{id}", s.getClusterProfile)
+	mux.HandleFunc("POST /clusterprofile/{id}", s.updateClusterProfile)
+	mux.HandleFunc("DELETE /clusterprofile/{id}", s.deleteClusterProfile)
+	mux.HandleFunc("GET /cluster/{id}/schedule", s.getClusterSchedule)
+	mux.HandleFunc("POST /cluster/{id}/schedule", s.setClusterSchedule)
+	mux.HandleFunc("DELETE /cluster/{id}/schedule", s.deleteClusterSchedule)
+	mux.HandleFunc("GET /cluster/{id}/endpoints", s.getClusterEndpoints)
+	mux.HandleFunc("GET /cluster/{id}/allowlist", s.getClusterIPAllowlist)
+	mux.HandleFunc("POST /cluster/{id}/allowlist", s.setClusterIPAllowlist)
+	mux.HandleFunc("GET /environment/{id}/privateendpoint", s.getPrivateEndpointService)
+	mux.HandleFunc("POST /environment/{id}/privateendpoint", s.setPrivateEndpointService)
+	mux.HandleFunc("DELETE /environment/{id}/privateendpoint", s.deletePrivateEndpointService)
+	mux.HandleFunc("POST /environment/{id}/privateendpoint/connections", s.createPrivateEndpointConnection)
+	mux.HandleFunc("GET /privateendpointconnection/{id}", s.getPrivateEndpointConnection)
+	mux.HandleFunc("POST /privateendpointconnection/{id}", s.updatePrivateEndpointConnection)
+	mux.HandleFunc("DELETE /privateendpointconnection/{id}", s.deletePrivateEndpointConnection)
+	mux.HandleFunc("GET /cluster/{id}/backupschedule", s.getBackupSchedule)
+	mux.HandleFunc("POST /cluster/{id}/backupschedule", s.setBackupSchedule)
+	mux.HandleFunc("DELETE /cluster/{id}/backupschedule", s.deleteBackupSchedule)
+	mux.HandleFunc("GET /cluster/{id}/backups", s.listBackups)
+	mux.HandleFunc("POST /cluster/{id}/backups", s.createBackup)
+	mux.HandleFunc("GET /backup/{id}", s.getBackup)
+	mux.HandleFunc("DELETE /backup/{id}", s.deleteBackup)
+	mux.HandleFunc("POST /cluster/{id}/restore", s.startRestore)
+	mux.HandleFunc("GET /restore/{id}", s.getRestore)
+
+	s.Server = httptest.NewServer(s.middleware(mux))
+	t.Cleanup(s.Close)
+	return s
+}
+
+// Inject - adds a fault applied to subsequent matching requests.
+func (s *Server) Inject(f Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = append(s.faults, &f)
+}
+
+// Requests - returns the method and path of every request received, e.g. "GET /nodetype/1000".
+func (s *Server) Requests() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.requests...)
+}
+
+// AddEnvironment - seeds an environment and returns it with its ID.
+func (s *Server) AddEnvironment(env client.Environment) client.Environment {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if env.ID == "" {
+		env.ID = s.newID()
+	}
+	s.environments[env.ID] = env
+	return env
+}
+
+// AddNodeType - seeds a node type in an environment and returns it with its ID.
+func (s *Server) AddNodeType(envID string, nt client.NodeType) client.NodeType {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if nt.ID == "" {
+		nt.ID = s.newID()
+	}
+	s.nodeTypes[nt.ID] = nodeType{NodeType: nt, envID: envID}
+	return nt
+}
+
+// NodeType - returns the stored node type by ID.
+func (s *Server) NodeType(ID string) (client.NodeType, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	nt, ok := s.nodeTypes[ID]
+	return nt.NodeType, ok
+}
+
+// AddCluster - seeds an online cluster and returns it with its ID.
+func (s *Server) AddCluster(c client.Cluster) client.Cluster {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if c.ID == "" {
+		c.ID = s.newID()
+	}
+	c.Status = client.ClusterStatusOnline
+	s.clusters[c.ID] = cluster{Cluster: c}
+	return c
+}
+
+// Cluster - returns the stored cluster by ID.
+func (s *Server) Cluster(ID string) (client.Cluster, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c, ok := s.clusters[ID]
+	return c.Cluster, ok
+}
+
+// newID - returns a new unique object ID, callers hold the lock.
+func (s *Server) newID() string {
+	s.nextID++
+	return strconv.Itoa(s.nextID)
+}
+
+// middleware - records requests, applies faults and checks the API token.
+func (s *Server) middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		s.requests = append(s.requests, r.Method+" "+r.URL.Path)
+		fault := s.matchFault(r)
+		s.mu.Unlock()
+
+		if fault.Latency > 0 {
+			select {
+			case <-time.After(fault.Latency):
+			case <-r.Context().Done():
+				return
+			}
+		}
+		if fault.Status != 0 {
+			if fault.Status == http.StatusTooManyRequests {
+				w.Header().Set("Retry-After", "0")
+			}
+			writeError(w, fault.Status, "injected", http.StatusText(fault.Status), nil)
+			return
+		}
+
+		if r.Header.Get("X-Auth-Token") != Token {
+			writeError(w, http.StatusUnauthorized, "unauthorized", "invalid API token", nil)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// matchFault - returns the first fault matching the request and uses it up, callers hold the lock.
+func (s *Server) matchFault(r *http.Request) Fault {
+	for i, f := range s.faults {
+		if f.Method != "" && f.Method != r.Method {
+			continue
+		}
+		if !strings.HasPrefix(r.URL.Path, f.Path) {
+			continue
+		}
+		if f.Times > 0 {
+			f.Times--
+			if f.Times == 0 {
+				s.faults = append(s.faults[:i], s.faults[i+1:]...)
+			}
+		}
+		return *f
+	}
+	return Fault{}
+}
+
+// writeData - writes a successful response with the object under "data".
+func writeData(w http.ResponseWriter, data any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set(client.RequestIDHeader, "fake-"+strconv.FormatInt(time.Now().UnixNano(), 36))
+	_ = json.NewEncoder(w).Encode(map[string]any{"data": data})
+}
+
+// writeError - writes an error response in the Altinity.Cloud API format.
+func writeError(w http.ResponseWriter, status int, code, message string, fields []client.FieldError) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set(client.RequestIDHeader, "fake-"+strconv.FormatInt(time.Now().UnixNano(), 36))
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"error": map[string]any{"code": code, "message": message, "fields": fields},
+	})
+}
+
+// writeNotFound - writes a 404 response for a missing object.
+func writeNotFound(w http.ResponseWriter, kind, ID string) {
+	writeError(w, http.StatusNotFound, "not_found", fmt.Sprintf("%s %s not found", kind, ID), nil)
+}
+
+// requireParams - writes a 422 response listing missing query or form params and returns false if any are missing.
+func requireParams(w http.ResponseWriter, r *http.Request, names ...string) bool {
+	var fields []client.FieldError
+	for _, name := range names {
+		if r.FormValue(name) == "" {
+			fields = append(fields, client.FieldError{Field: name, Message: "is required"})
+		}
+	}
+	if len(fields) > 0 {
+		writeError(w, http.StatusUnprocessableEntity, "validation_failed", "invalid request", fields)
+		return false
+	}
+	return true
+}
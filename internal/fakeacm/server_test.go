@@ -0,0 +1,169 @@
+package fakeacm
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/tatari-tv/terraform-provider-altinitycloud/cmd/client"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// newClient - Altinity.Cloud client talking to the fake server without retry delays.
+func newClient(t *testing.T, s *Server) *client.AltinityCloudClient {
+	endpoint, token := s.URL, Token
+	c, err := client.NewClient(&endpoint, &token)
+	if err != nil {
+		t.Fatalf(`NewClient(), want nil got %v`, err)
+	}
+	c.RetryWaitMin = time.Millisecond
+	c.RetryWaitMax = time.Millisecond
+	return c
+}
+
+func TestNodeTypeLifecycle(t *testing.T) {
+	ctx := context.Background()
+	s := NewServer(t)
+	c := newClient(t, s)
+
+	nt, err := c.CreateNodeType(ctx, "648", client.NodeType{Name: "m6i.xlarge", Scope: "ClickHouse", CPU: "4", Memory: "16384", NodeSelector: client.NodeSelector{"disktype": "ssd"}})
+	assert.Nil(t, err)
+	assert.NotEmpty(t, nt.ID)
+	assert.Equal(t, client.NodeSelector{"disktype": "ssd"}, nt.NodeSelector)
+
+	_, err = c.CreateNodeType(ctx, "648", client.NodeType{Name: "m6i.xlarge", Scope: "ClickHouse", CPU: "4", Memory: "16384"})
+	assert.True(t, client.IsConflict(err))
+
+	nt.Name = "renamed"
+	_, err = c.UpdateNodeType(ctx, nt)
+	assert.Nil(t, err)
+
+	got, err := c.GetNodeTypeByID(ctx, nt.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, "renamed", got.Name)
+
+	nts, err := c.GetNodeTypes(ctx, "648")
+	assert.Nil(t, err)
+	assert.Len(t, nts.NodeTypes, 1)
+
+	assert.Nil(t, c.DeleteNodeType(ctx, nt.ID))
+	_, err = c.GetNodeTypeByID(ctx, nt.ID)
+	assert.True(t, client.IsNotFound(err))
+}
+
+func TestClusterLaunch(t *testing.T) {
+	ctx := context.Background()
+	s := NewServer(t)
+	s.ClusterLaunchPolls = 1
+	c := newClient(t, s)
+
+	cl, err := c.CreateCluster(ctx, "648", client.Cluster{Name: "analytics", Version: "24.3", NodeType: "m6i.xlarge", Shards: 1, Replicas: 2, DiskSize: 100, AdminPassword: "secret"})
+	assert.Nil(t, err)
+	assert.Equal(t, client.ClusterStatusLaunching, cl.Status)
+	assert.Empty(t, cl.AdminPassword)
+
+	cl, _ = c.GetCluster(ctx, cl.ID)
+	assert.Equal(t, client.ClusterStatusLaunching, cl.Status)
+	cl, _ = c.GetCluster(ctx, cl.ID)
+	assert.Equal(t, client.ClusterStatusOnline, cl.Status)
+
+	stored, _ := s.Cluster(cl.ID)
+	assert.Equal(t, "secret", stored.AdminPassword)
+
+	_, err = c.CreateCluster(ctx, "648", client.Cluster{Name: "bad", Version: "24.3", NodeType: "m6i.xlarge", Shards: -1, Replicas: 1, DiskSize: 100})
+	var apiErr *client.APIError
+	if assert.ErrorAs(t, err, &apiErr) {
+		assert.Equal(t, "shards", apiErr.Fields[0].Field)
+	}
+}
+
+func TestClusterRescale(t *testing.T) {
+	ctx := context.Background()
+	s := NewServer(t)
+	c := newClient(t, s)
+
+	cl, err := c.CreateCluster(ctx, "648", client.Cluster{Name: "analytics", Version: "24.3", NodeType: "m6i.xlarge", Shards: 1, Replicas: 2, DiskSize: 100})
+	assert.Nil(t, err)
+
+	s.ClusterLaunchPolls = 1
+	cl.Shards, cl.DiskSize = 2, 200
+	cl, err = c.RescaleCluster(ctx, cl)
+	assert.Nil(t, err)
+	assert.Equal(t, client.ClusterStatusRescaling, cl.Status)
+	assert.Equal(t, int64(2), cl.Shards)
+
+	cl, _ = c.GetCluster(ctx, cl.ID)
+	assert.Equal(t, client.ClusterStatusRescaling, cl.Status)
+	cl, _ = c.GetCluster(ctx, cl.ID)
+	assert.Equal(t, client.ClusterStatusOnline, cl.Status)
+	assert.Equal(t, 1, s.Rescales(cl.ID))
+
+	// data volumes only grow
+	cl.DiskSize = 50
+	_, err = c.RescaleCluster(ctx, cl)
+	var apiErr *client.APIError
+	if assert.ErrorAs(t, err, &apiErr) {
+		assert.Equal(t, "size", apiErr.Fields[0].Field)
+	}
+}
+
+func TestClusterUpgrade(t *testing.T) {
+	ctx := context.Background()
+	s := NewServer(t)
+	c := newClient(t, s)
+
+	cl, err := c.CreateCluster(ctx, "648", client.Cluster{Name: "analytics", Version: "24.3.5.47.altinitystable", NodeType: "m6i.xlarge", Shards: 1, Replicas: 2, DiskSize: 100})
+	assert.Nil(t, err)
+
+	cl, err = c.UpgradeCluster(ctx, cl.ID, "24.8.14.10459.altinitystable", false)
+	assert.Nil(t, err)
+	assert.Equal(t, client.ClusterStatusUpgrading, cl.Status)
+	cl, _ = c.GetCluster(ctx, cl.ID)
+	assert.Equal(t, client.ClusterStatusOnline, cl.Status)
+	assert.Equal(t, "24.8.14.10459.altinitystable", cl.Version)
+
+	// downgrades have to be allowed explicitly
+	_, err = c.UpgradeCluster(ctx, cl.ID, "24.3.12.75.altinitystable", false)
+	var apiErr *client.APIError
+	if assert.ErrorAs(t, err, &apiErr) {
+		assert.Equal(t, "version", apiErr.Fields[0].Field)
+	}
+	_, err = c.UpgradeCluster(ctx, cl.ID, "24.3.12.75.altinitystable", true)
+	assert.Nil(t, err)
+
+	_, err = c.UpgradeCluster(ctx, cl.ID, "99.1", false)
+	assert.ErrorAs(t, err, &apiErr)
+}
+
+func TestInjectedFaults(t *testing.T) {
+	ctx := context.Background()
+	s := NewServer(t)
+	c := newClient(t, s)
+	nt := s.AddNodeType("648", client.NodeType{Name: "m6i.xlarge"})
+
+	// transient errors are retried
+	s.Inject(Fault{Method: http.MethodGet, Path: "/nodetype/", Status: http.StatusTooManyRequests, Times: 2})
+	_, err := c.GetNodeTypeByID(ctx, nt.ID)
+	assert.Nil(t, err)
+	assert.Len(t, s.Requests(), 3)
+
+	s.Inject(Fault{Path: "/environment/", Status: http.StatusInternalServerError, Times: 1})
+	_, err = c.GetNodeTypes(ctx, "648")
+	assert.NotNil(t, err)
+
+	// latency is bounded by the request context
+	s.Inject(Fault{Latency: time.Second, Times: 1})
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	_, err = c.GetNodeTypeByID(ctx, nt.ID)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestInvalidToken(t *testing.T) {
+	s := NewServer(t)
+	endpoint, token := s.URL, "wrong"
+	c, _ := client.NewClient(&endpoint, &token)
+
+	_, err := c.GetEnvironments(context.Background())
+	assert.True(t, client.IsUnauthorized(err))
+}
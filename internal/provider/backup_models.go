@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// BackupScheduleResourceModel - describes the backup schedule model for resources.
+type BackupScheduleResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	ClusterID   types.String `tfsdk:"cluster_id"`
+	Schedule    types.String `tfsdk:"schedule"`
+	Retention   types.Int64  `tfsdk:"retention"`
+	Bucket      types.String `tfsdk:"bucket"`
+	Compression types.String `tfsdk:"compression"`
+	LastUpdated types.String `tfsdk:"last_updated"`
+}
+
+// BackupResourceModel - describes the on-demand backup model for resources.
+type BackupResourceModel struct {
+	ID          types.String   `tfsdk:"id"`
+	ClusterID   types.String   `tfsdk:"cluster_id"`
+	Name        types.String   `tfsdk:"name"`
+	Status      types.String   `tfsdk:"status"`
+	Size        types.Int64    `tfsdk:"size"`
+	CreatedAt   types.String   `tfsdk:"created_at"`
+	LastUpdated types.String   `tfsdk:"last_updated"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+// BackupsDataSourceModel - describes the backups of a cluster for data sources.
+type BackupsDataSourceModel struct {
+	ClusterID types.String  `tfsdk:"cluster_id"`
+	Backups   []BackupModel `tfsdk:"backups"`
+}
+
+// BackupModel - backup datasource representation.
+type BackupModel struct {
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	Status    types.String `tfsdk:"status"`
+	Size      types.Int64  `tfsdk:"size"`
+	CreatedAt types.String `tfsdk:"created_at"`
+}
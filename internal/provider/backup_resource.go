@@ -0,0 +1,258 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/tatari-tv/terraform-provider-altinitycloud/cmd/client"
+	"time"
+)
+
+// backupTimeout - how long to wait for a backup to complete.
+const backupTimeout = 120 * time.Minute
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &backupResource{}
+	_ resource.ResourceWithConfigure = &backupResource{}
+)
+
+// NewBackupResource is a helper function to simplify the provider implementation.
+func NewBackupResource() resource.Resource {
+	return &backupResource{}
+}
+
+// backupResource is the resource implementation.
+type backupResource struct {
+	client *client.AltinityCloudClient
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *backupResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.AltinityCloudClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Backup Source Configure Type",
+			fmt.Sprintf("Expected *client.AltinityCloudClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Metadata - returns the resource type name.
+func (r *backupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_backup"
+}
+
+// Schema - defines the schema for the resource.
+func (r *backupResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Takes a named on-demand backup of an Altinity.Cloud cluster and waits for it to complete. " +
+			"Changing `name` takes a new backup, destroying the resource deletes the backup from the backup storage.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Altinity.Cloud backup ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cluster_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Altinity.Cloud cluster ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Backup name, unique within the cluster.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Backup status (`in_progress`, `completed` or `failed`).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"size": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Backup size in bytes.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "When the backup was started, as an RFC 3339 timestamp.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Altinity.Cloud backup last updated timestamp. This is auto-generated by the provider.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+// Create - starts the backup, waits for it to complete and sets the initial Terraform state.
+func (r *backupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating backup resource")
+	// Retrieve values from plan
+	var plan BackupResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Failed to retrieve plan for Altinity.Cloud backup resource")
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, backupTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Start new backup
+	tflog.Info(ctx, fmt.Sprintf("Starting backup %s of cluster %s", plan.Name.ValueString(), plan.ClusterID.ValueString()))
+	backup, err := r.client.CreateBackup(ctx, plan.ClusterID.ValueString(), plan.Name.ValueString())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error creating backup", "Could not start backup", err, backupFieldPaths)
+		return
+	}
+
+	// Wait for the backup to complete, a failed or unfinished backup is saved to state and tainted
+	waited, err := r.client.WaitForBackup(ctx, backup.ID)
+	if waited.ID != "" {
+		backup = waited
+	}
+	if err != nil {
+		addWaitError(&resp.Diagnostics, "Error creating backup", "Backup "+backup.ID+" did not complete", err)
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	mapBackupToBackupModel(backup, &plan)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	// Set plan to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *backupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Read Altinity.Cloud backup resource")
+	// Get current state
+	var state BackupResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed backup from Altinity.Cloud, it may have been removed by retention
+	backup, err := r.client.GetBackup(ctx, state.ID.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, fmt.Sprintf("backup %s not found, removing it from state", state.ID.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error retrieving backup", "Could not retrieve backup", err, nil)
+		return
+	}
+
+	// Overwrite current state with refreshed data
+	mapBackupToBackupModel(backup, &state)
+
+	tflog.Trace(ctx, fmt.Sprintf("refreshed backup %s from API", state.ID.ValueString()))
+
+	// set refreshed state
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update - every configurable attribute requires a new backup, so there is nothing to send to the API.
+func (r *backupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Update backup resource")
+	var plan BackupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete - deletes the backup and removes the Terraform state on success.
+func (r *backupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Delete backup resource")
+	// Retrieve values from state
+	var state BackupResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing backup, it may already be gone
+	err := r.client.DeleteBackup(ctx, state.ID.ValueString())
+	if client.IsNotFound(err) {
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error deleting backup", "Could not delete backup", err, nil)
+		return
+	}
+}
+
+// mapBackupToBackupModel - copies the API response into the Terraform model.
+func mapBackupToBackupModel(backup client.Backup, m *BackupResourceModel) {
+	m.ID = types.StringValue(backup.ID)
+	m.Status = types.StringValue(backup.Status)
+	m.Size = types.Int64Value(backup.Size)
+	m.CreatedAt = types.StringValue(backup.CreatedAt)
+
+	// the API does not echo the name and cluster when the backup could not be read
+	if len(backup.Name) > 0 {
+		m.Name = types.StringValue(backup.Name)
+	}
+	if len(backup.ClusterID) > 0 {
+		m.ClusterID = types.StringValue(backup.ClusterID)
+	}
+}
@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/tatari-tv/terraform-provider-altinitycloud/cmd/client"
+	"github.com/tatari-tv/terraform-provider-altinitycloud/internal/fakeacm"
+	"testing"
+)
+
+func TestAccBackupResource(t *testing.T) {
+	s := fakeacm.NewServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create waits for the backup to complete
+			{
+				Config: testAccProviderConfig(s) + testAccBackupResourceConfig("before-upgrade"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("altinitycloud_backup.test", "id"),
+					resource.TestCheckResourceAttr("altinitycloud_backup.test", "status", client.BackupStatusCompleted),
+					resource.TestCheckResourceAttr("altinitycloud_backup.test", "size", "1073741824"),
+					resource.TestCheckResourceAttrSet("altinitycloud_backup.test", "created_at"),
+				),
+			},
+			// A new name takes a new backup
+			{
+				Config: testAccProviderConfig(s) + testAccBackupResourceConfig("after-upgrade"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("altinitycloud_backup.test", "name", "after-upgrade"),
+					resource.TestCheckResourceAttr("altinitycloud_backup.test", "status", client.BackupStatusCompleted),
+				),
+			},
+		},
+	})
+}
+
+func testAccBackupResourceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "altinitycloud_cluster" "test" {
+  env_id         = "648"
+  name           = "tf-acc"
+  version        = "24.3.5.47.altinitystable"
+  node_type      = "m6i.xlarge"
+  disk_size      = 100
+  admin_password = "admin-secret"
+}
+
+resource "altinitycloud_backup" "test" {
+  cluster_id = altinitycloud_cluster.test.id
+  name       = %q
+}
+`, name)
+}
@@ -0,0 +1,270 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/tatari-tv/terraform-provider-altinitycloud/cmd/client"
+	"time"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &backupScheduleResource{}
+	_ resource.ResourceWithConfigure   = &backupScheduleResource{}
+	_ resource.ResourceWithImportState = &backupScheduleResource{}
+)
+
+// NewBackupScheduleResource is a helper function to simplify the provider implementation.
+func NewBackupScheduleResource() resource.Resource {
+	return &backupScheduleResource{}
+}
+
+// backupScheduleResource is the resource implementation.
+type backupScheduleResource struct {
+	client *client.AltinityCloudClient
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *backupScheduleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.AltinityCloudClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Backup Schedule Source Configure Type",
+			fmt.Sprintf("Expected *client.AltinityCloudClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Metadata - returns the resource type name.
+func (r *backupScheduleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_backup_schedule"
+}
+
+// Schema - defines the schema for the resource.
+func (r *backupScheduleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the scheduled backups of an Altinity.Cloud cluster. A cluster has at most one backup schedule, " +
+			"destroying the resource disables scheduled backups but keeps existing backups.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Backup schedule ID, same as `cluster_id`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cluster_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Altinity.Cloud cluster ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"schedule": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "When to take backups as a cron expression in UTC, e.g. `0 3 * * *` for every day at 03:00.",
+				Validators: []validator.String{
+					cronSchedule(),
+				},
+			},
+			"retention": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "Number of scheduled backups to keep, older ones are deleted.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"bucket": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Object storage bucket to store backups in. Defaults to the backup bucket of the environment.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"compression": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Backup compression format, one of `tar`, `gzip`, `lz4`, `zstd`, `brotli`, `bzip2` or `xz`. Defaults to `tar`.",
+				Default:             stringdefault.StaticString("tar"),
+				Validators: []validator.String{
+					stringvalidator.OneOf(backupCompressions...),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Backup schedule last updated timestamp. This is auto-generated by the provider.",
+			},
+		},
+	}
+}
+
+// Create - sets the backup schedule of the cluster and sets the initial Terraform state.
+func (r *backupScheduleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating backup schedule resource")
+	// Retrieve values from plan
+	var plan BackupScheduleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Failed to retrieve plan for Altinity.Cloud backup schedule resource")
+		return
+	}
+
+	// Set backup schedule
+	tflog.Info(ctx, fmt.Sprintf("Setting backup schedule of cluster %s", plan.ClusterID.ValueString()))
+	schedule, err := r.client.SetBackupSchedule(ctx, mapBackupScheduleModelToBackupSchedule(plan))
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error creating backup schedule", "Could not set backup schedule", err, backupScheduleFieldPaths)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	mapBackupScheduleToBackupScheduleModel(schedule, &plan)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	// Set plan to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *backupScheduleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Read Altinity.Cloud backup schedule resource")
+	// Get current state
+	var state BackupScheduleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed backup schedule from Altinity.Cloud, scheduled backups may have been disabled outside Terraform
+	schedule, err := r.client.GetBackupSchedule(ctx, state.ClusterID.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, fmt.Sprintf("backup schedule of cluster %s not found, removing it from state", state.ClusterID.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error retrieving backup schedule", "Could not retrieve backup schedule", err, nil)
+		return
+	}
+
+	// Overwrite current state with refreshed data
+	mapBackupScheduleToBackupScheduleModel(schedule, &state)
+
+	tflog.Trace(ctx, fmt.Sprintf("refreshed backup schedule of cluster %s from API", state.ClusterID.ValueString()))
+
+	// set refreshed state
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update - replaces the backup schedule and sets the updated Terraform state on success.
+func (r *backupScheduleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Update backup schedule resource")
+	// Retrieve values from plan
+	var plan BackupScheduleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Failed to retrieve plan for Altinity.Cloud backup schedule resource")
+		return
+	}
+
+	// Update backup schedule in Altinity.Cloud
+	tflog.Info(ctx, fmt.Sprintf("Updating backup schedule of cluster %s", plan.ClusterID.ValueString()))
+	schedule, err := r.client.SetBackupSchedule(ctx, mapBackupScheduleModelToBackupSchedule(plan))
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error updating backup schedule", "Could not update backup schedule", err, backupScheduleFieldPaths)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	mapBackupScheduleToBackupScheduleModel(schedule, &plan)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	// Set plan to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete - disables scheduled backups and removes the Terraform state on success.
+func (r *backupScheduleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Delete backup schedule resource")
+	// Retrieve values from state
+	var state BackupScheduleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Disable scheduled backups, they may already be disabled
+	err := r.client.DeleteBackupSchedule(ctx, state.ClusterID.ValueString())
+	if client.IsNotFound(err) {
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error deleting backup schedule", "Could not delete backup schedule", err, nil)
+		return
+	}
+}
+
+// ImportState - imports the backup schedule of a cluster by the cluster ID.
+func (r *backupScheduleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, "Import backup schedule resource")
+	// Retrieve import ID and save to id and cluster_id attributes
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_id"), req.ID)...)
+}
+
+// mapBackupScheduleModelToBackupSchedule - converts the Terraform model into an API request.
+func mapBackupScheduleModelToBackupSchedule(m BackupScheduleResourceModel) client.BackupSchedule {
+	return client.BackupSchedule{
+		ClusterID:   m.ClusterID.ValueString(),
+		Schedule:    m.Schedule.ValueString(),
+		Retention:   m.Retention.ValueInt64(),
+		Bucket:      m.Bucket.ValueString(),
+		Compression: m.Compression.ValueString(),
+	}
+}
+
+// mapBackupScheduleToBackupScheduleModel - copies the API response into the Terraform model.
+func mapBackupScheduleToBackupScheduleModel(schedule client.BackupSchedule, m *BackupScheduleResourceModel) {
+	m.ID = m.ClusterID
+	m.Schedule = types.StringValue(schedule.Schedule)
+	m.Retention = types.Int64Value(schedule.Retention)
+	m.Bucket = types.StringValue(schedule.Bucket)
+	m.Compression = types.StringValue(schedule.Compression)
+}
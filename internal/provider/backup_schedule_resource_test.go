@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/tatari-tv/terraform-provider-altinitycloud/internal/fakeacm"
+	"regexp"
+	"testing"
+)
+
+func TestAccBackupScheduleResource(t *testing.T) {
+	s := fakeacm.NewServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The schedule is validated during plan
+			{
+				Config:      testAccProviderConfig(s) + testAccBackupScheduleResourceConfig(`schedule = "daily"`),
+				ExpectError: regexp.MustCompile(`must be a cron expression`),
+			},
+			// Create and Read testing, the environment bucket is used by default
+			{
+				Config: testAccProviderConfig(s) + testAccBackupScheduleResourceConfig(`schedule = "0 3 * * *"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("altinitycloud_backup_schedule.test", "id", "altinitycloud_cluster.test", "id"),
+					resource.TestCheckResourceAttr("altinitycloud_backup_schedule.test", "bucket", fakeacm.DefaultBackupBucket),
+					resource.TestCheckResourceAttr("altinitycloud_backup_schedule.test", "compression", "tar"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "altinitycloud_backup_schedule.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			// Update testing
+			{
+				Config: testAccProviderConfig(s) + testAccBackupScheduleResourceConfig(`schedule    = "0 */6 * * *"
+  bucket      = "tenant-backups"
+  compression = "zstd"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("altinitycloud_backup_schedule.test", "bucket", "tenant-backups"),
+					testAccCheckBackupSchedule(s, "0 */6 * * *", "zstd"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckBackupSchedule - checks the backup schedule the fake API stored for the test cluster.
+func testAccCheckBackupSchedule(s *fakeacm.Server, schedule, compression string) resource.TestCheckFunc {
+	return func(st *terraform.State) error {
+		rs, ok := st.RootModule().Resources["altinitycloud_cluster.test"]
+		if !ok {
+			return fmt.Errorf("altinitycloud_cluster.test not found in state")
+		}
+		bs, ok := s.BackupSchedule(rs.Primary.ID)
+		if !ok {
+			return fmt.Errorf("backup schedule of cluster %s not found in the API", rs.Primary.ID)
+		}
+		if bs.Schedule != schedule || bs.Compression != compression {
+			return fmt.Errorf("backup schedule is %q with %s compression, want %q with %s", bs.Schedule, bs.Compression, schedule, compression)
+		}
+		return nil
+	}
+}
+
+func testAccBackupScheduleResourceConfig(schedule string) string {
+	return fmt.Sprintf(`
+resource "altinitycloud_cluster" "test" {
+  env_id         = "648"
+  name           = "tf-acc"
+  version        = "24.3.5.47.altinitystable"
+  node_type      = "m6i.xlarge"
+  disk_size      = 100
+  admin_password = "admin-secret"
+}
+
+resource "altinitycloud_backup_schedule" "test" {
+  cluster_id = altinitycloud_cluster.test.id
+  retention  = 7
+  %s
+}
+`, schedule)
+}
@@ -0,0 +1,141 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/tatari-tv/terraform-provider-altinitycloud/cmd/client"
+	"sort"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &backupsDataSource{}
+	_ datasource.DataSourceWithConfigure = &backupsDataSource{}
+)
+
+func NewBackupsDataSource() datasource.DataSource {
+	return &backupsDataSource{}
+}
+
+// backupsDataSource - defines the backups data source implementation.
+type backupsDataSource struct {
+	client *client.AltinityCloudClient
+}
+
+// Metadata - returns the altinitycloud_backups type name.
+func (d *backupsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_backups"
+}
+
+// Schema - defines the backups schema.
+func (d *backupsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the scheduled and on-demand backups of an Altinity.Cloud cluster, newest first.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Altinity.Cloud cluster ID.",
+			},
+			"backups": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Backups of the cluster.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Altinity.Cloud backup ID.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Backup name.",
+						},
+						"status": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Backup status (`in_progress`, `completed` or `failed`).",
+						},
+						"size": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Backup size in bytes.",
+						},
+						"created_at": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "When the backup was started, as an RFC 3339 timestamp.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure - bootstraps backups datasource with Altinity.Cloud client.
+func (d *backupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring backups data source")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.AltinityCloudClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *altinityCloudClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read - lists the backups of the cluster.
+func (d *backupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading backups data source")
+	var state BackupsDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bd, err := d.client.GetBackups(ctx, state.ClusterID.ValueString())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Client Error", "Unable to list backups", err, nil)
+		return
+	}
+
+	state.Backups = []BackupModel{}
+	for _, b := range sortBackups(bd.Backups) {
+		state.Backups = append(state.Backups, BackupModel{
+			ID:        types.StringValue(b.ID),
+			Name:      types.StringValue(b.Name),
+			Status:    types.StringValue(b.Status),
+			Size:      types.Int64Value(b.Size),
+			CreatedAt: types.StringValue(b.CreatedAt),
+		})
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("found %d backups of cluster %v", len(state.Backups), state.ClusterID))
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// sortBackups - returns the backups newest first, RFC 3339 timestamps in UTC sort as strings.
+func sortBackups(bs []client.Backup) []client.Backup {
+	sorted := append([]client.Backup{}, bs...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].CreatedAt > sorted[j].CreatedAt
+	})
+	return sorted
+}
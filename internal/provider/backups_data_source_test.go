@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/tatari-tv/terraform-provider-altinitycloud/cmd/client"
+	"github.com/tatari-tv/terraform-provider-altinitycloud/internal/fakeacm"
+	"testing"
+)
+
+func TestSortBackups(t *testing.T) {
+	bs := []client.Backup{
+		{Name: "a", CreatedAt: "2024-05-01T03:00:00Z"},
+		{Name: "b", CreatedAt: "2024-05-03T03:00:00Z"},
+		{Name: "c", CreatedAt: "2024-05-02T03:00:00Z"},
+	}
+
+	sorted := sortBackups(bs)
+	assert.Equal(t, []string{"b", "c", "a"}, []string{sorted[0].Name, sorted[1].Name, sorted[2].Name})
+	// the input is left as is
+	assert.Equal(t, "a", bs[0].Name)
+}
+
+func TestAccBackupsDataSource(t *testing.T) {
+	s := fakeacm.NewServer(t)
+	s.AddBackup(client.Backup{ClusterID: "42", Name: "scheduled-1", Status: client.BackupStatusCompleted, Size: 2048, CreatedAt: "2024-05-01T03:00:00Z"})
+	s.AddBackup(client.Backup{ClusterID: "42", Name: "scheduled-2", Status: client.BackupStatusFailed, CreatedAt: "2024-05-02T03:00:00Z"})
+	s.AddBackup(client.Backup{ClusterID: "43", Name: "other", Status: client.BackupStatusCompleted, CreatedAt: "2024-05-02T03:00:00Z"})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(s) + `
+data "altinitycloud_backups" "test" {
+  cluster_id = "42"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.altinitycloud_backups.test", "backups.#", "2"),
+					resource.TestCheckResourceAttr("data.altinitycloud_backups.test", "backups.0.name", "scheduled-2"),
+					resource.TestCheckResourceAttr("data.altinitycloud_backups.test", "backups.0.status", "failed"),
+					resource.TestCheckResourceAttr("data.altinitycloud_backups.test", "backups.1.size", "2048"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,21 @@
+package provider
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// ClickHouseVersionsDataSourceModel - describes the ClickHouse versions of an environment for data sources.
+type ClickHouseVersionsDataSourceModel struct {
+	EnvID          types.String             `tfsdk:"env_id"`
+	AltinityStable types.Bool               `tfsdk:"altinity_stable"`
+	LTS            types.Bool               `tfsdk:"lts"`
+	Latest         types.String             `tfsdk:"latest"`
+	Versions       []ClickHouseVersionModel `tfsdk:"versions"`
+}
+
+// ClickHouseVersionModel - ClickHouse version datasource representation.
+type ClickHouseVersionModel struct {
+	Version        types.String `tfsdk:"version"`
+	Release        types.String `tfsdk:"release"`
+	Image          types.String `tfsdk:"image"`
+	AltinityStable types.Bool   `tfsdk:"altinity_stable"`
+	LTS            types.Bool   `tfsdk:"lts"`
+}
@@ -0,0 +1,222 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/tatari-tv/terraform-provider-altinitycloud/cmd/client"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &clickHouseVersionsDataSource{}
+	_ datasource.DataSourceWithConfigure = &clickHouseVersionsDataSource{}
+)
+
+func NewClickHouseVersionsDataSource() datasource.DataSource {
+	return &clickHouseVersionsDataSource{}
+}
+
+// clickHouseVersionsDataSource - defines the ClickHouse versions data source implementation.
+type clickHouseVersionsDataSource struct {
+	client *client.AltinityCloudClient
+}
+
+// clickHouseVersionFilter - criteria a ClickHouse version has to match to be returned.
+type clickHouseVersionFilter struct {
+	AltinityStable *bool
+	LTS            *bool
+}
+
+// clickHouseVersionRegexp - numeric part of a ClickHouse version, e.g. `24.3.5.47` of `24.3.5.47.altinitystable`.
+var clickHouseVersionRegexp = regexp.MustCompile(`^\d+(\.\d+)*`)
+
+// Metadata - returns the altinitycloud_clickhouse_versions type name.
+func (d *clickHouseVersionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_clickhouse_versions"
+}
+
+// Schema - defines the clickhouse_versions schema.
+func (d *clickHouseVersionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the ClickHouse versions available to an Altinity.Cloud environment matching all the given filters, newest first.",
+		Attributes: map[string]schema.Attribute{
+			"env_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Altinity.Cloud environment ID",
+			},
+			"altinity_stable": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return Altinity Stable builds when `true`, or only other builds when `false`.",
+			},
+			"lts": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return long-term support releases when `true`, or only other releases when `false`.",
+			},
+			"latest": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Newest version matching the filters, unset when no version matches.",
+			},
+			"versions": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "ClickHouse versions matching the filters.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"version": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "ClickHouse version as used by the cluster `version` attribute, e.g. `24.3.5.47.altinitystable`.",
+						},
+						"release": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "ClickHouse release the version belongs to, e.g. `24.3`.",
+						},
+						"image": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Docker image of the build.",
+						},
+						"altinity_stable": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the build is an Altinity Stable build.",
+						},
+						"lts": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the release is a long-term support release.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure - bootstraps ClickHouse versions datasource with Altinity.Cloud client.
+func (d *clickHouseVersionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring ClickHouse versions data source")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.AltinityCloudClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *altinityCloudClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read - lists the ClickHouse versions of the environment and applies the filters.
+func (d *clickHouseVersionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading ClickHouse versions data source")
+	var state ClickHouseVersionsDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var filter clickHouseVersionFilter
+	if !state.AltinityStable.IsNull() {
+		v := state.AltinityStable.ValueBool()
+		filter.AltinityStable = &v
+	}
+	if !state.LTS.IsNull() {
+		v := state.LTS.ValueBool()
+		filter.LTS = &v
+	}
+
+	vd, err := d.client.GetClickHouseVersions(ctx, state.EnvID.ValueString())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Client Error", "Unable to list ClickHouse versions", err, nil)
+		return
+	}
+
+	state.Versions = []ClickHouseVersionModel{}
+	state.Latest = types.StringNull()
+	for _, v := range filterClickHouseVersions(vd.Versions, filter) {
+		state.Versions = append(state.Versions, ClickHouseVersionModel{
+			Version:        types.StringValue(v.Version),
+			Release:        types.StringValue(clickHouseRelease(v.Version)),
+			Image:          types.StringValue(v.Image),
+			AltinityStable: types.BoolValue(v.AltinityStable),
+			LTS:            types.BoolValue(v.LTS),
+		})
+	}
+	if len(state.Versions) > 0 {
+		state.Latest = state.Versions[0].Version
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("found %d of %d ClickHouse versions in environment %v", len(state.Versions), len(vd.Versions), state.EnvID))
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// filterClickHouseVersions - returns versions matching the filter, newest first.
+func filterClickHouseVersions(vs []client.ClickHouseVersion, f clickHouseVersionFilter) []client.ClickHouseVersion {
+	matched := []client.ClickHouseVersion{}
+	for _, v := range vs {
+		if f.AltinityStable != nil && v.AltinityStable != *f.AltinityStable {
+			continue
+		}
+		if f.LTS != nil && v.LTS != *f.LTS {
+			continue
+		}
+		matched = append(matched, v)
+	}
+
+	sort.SliceStable(matched, func(i, j int) bool {
+		return compareClickHouseVersions(matched[i].Version, matched[j].Version) > 0
+	})
+	return matched
+}
+
+// parseClickHouseVersion - parses the numeric part of a ClickHouse version, ignoring build suffixes like `altinitystable`.
+func parseClickHouseVersion(s string) (*version.Version, error) {
+	numeric := clickHouseVersionRegexp.FindString(s)
+	if numeric == "" {
+		return nil, fmt.Errorf("%q is not a ClickHouse version", s)
+	}
+	return version.NewVersion(numeric)
+}
+
+// compareClickHouseVersions - compares two ClickHouse versions, unparsable versions sort last by name.
+func compareClickHouseVersions(a, b string) int {
+	va, errA := parseClickHouseVersion(a)
+	vb, errB := parseClickHouseVersion(b)
+	switch {
+	case errA != nil && errB != nil:
+		return strings.Compare(a, b)
+	case errA != nil:
+		return -1
+	case errB != nil:
+		return 1
+	}
+	if c := va.Compare(vb); c != 0 {
+		return c
+	}
+	return strings.Compare(a, b)
+}
+
+// clickHouseRelease - returns the major and minor version, e.g. `24.3` of `24.3.5.47.altinitystable`.
+func clickHouseRelease(s string) string {
+	parts := strings.SplitN(clickHouseVersionRegexp.FindString(s), ".", 3)
+	return strings.Join(parts[:min(2, len(parts))], ".")
+}
@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/tatari-tv/terraform-provider-altinitycloud/cmd/client"
+	"github.com/tatari-tv/terraform-provider-altinitycloud/internal/fakeacm"
+	"testing"
+)
+
+func TestFilterClickHouseVersions(t *testing.T) {
+	vs := []client.ClickHouseVersion{
+		{Version: "24.3.5.47.altinitystable", AltinityStable: true, LTS: true},
+		{Version: "25.1.3.23"},
+		{Version: "24.3.12.75.altinitystable", AltinityStable: true, LTS: true},
+		{Version: "24.10.1.2812"},
+		{Version: "24.8.14.10459.altinitystable", AltinityStable: true, LTS: true},
+	}
+
+	versions := func(vs []client.ClickHouseVersion) []string {
+		res := []string{}
+		for _, v := range vs {
+			res = append(res, v.Version)
+		}
+		return res
+	}
+	boolean := func(v bool) *bool { return &v }
+
+	// 24.10 is newer than 24.8, versions are not sorted as strings
+	assert.Equal(t, []string{"25.1.3.23", "24.10.1.2812", "24.8.14.10459.altinitystable", "24.3.12.75.altinitystable", "24.3.5.47.altinitystable"},
+		versions(filterClickHouseVersions(vs, clickHouseVersionFilter{})))
+	assert.Equal(t, []string{"24.8.14.10459.altinitystable", "24.3.12.75.altinitystable", "24.3.5.47.altinitystable"},
+		versions(filterClickHouseVersions(vs, clickHouseVersionFilter{AltinityStable: boolean(true)})))
+	assert.Equal(t, []string{"25.1.3.23", "24.10.1.2812"},
+		versions(filterClickHouseVersions(vs, clickHouseVersionFilter{LTS: boolean(false)})))
+	assert.Empty(t, filterClickHouseVersions(vs, clickHouseVersionFilter{AltinityStable: boolean(false), LTS: boolean(true)}))
+}
+
+func TestCompareClickHouseVersions(t *testing.T) {
+	assert.Equal(t, -1, compareClickHouseVersions("24.3.12.75.altinitystable", "24.8.14.10459.altinitystable"))
+	assert.Equal(t, 1, compareClickHouseVersions("24.10.1.2812", "24.8.14.10459.altinitystable"))
+	assert.Equal(t, 0, compareClickHouseVersions("24.3.5.47.altinitystable", "24.3.5.47.altinitystable"))
+	assert.Equal(t, -1, compareClickHouseVersions("latest", "23.8"))
+	assert.Equal(t, "24.3", clickHouseRelease("24.3.5.47.altinitystable"))
+	assert.Equal(t, "25", clickHouseRelease("25"))
+}
+
+func TestAccClickHouseVersionsDataSource(t *testing.T) {
+	s := fakeacm.NewServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(s) + `
+data "altinitycloud_clickhouse_versions" "stable" {
+  env_id          = "648"
+  altinity_stable = true
+}
+
+data "altinitycloud_clickhouse_versions" "none" {
+  env_id          = "648"
+  altinity_stable = false
+  lts             = true
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.altinitycloud_clickhouse_versions.stable", "versions.#", "4"),
+					resource.TestCheckResourceAttr("data.altinitycloud_clickhouse_versions.stable", "latest", "24.8.14.10459.altinitystable"),
+					resource.TestCheckResourceAttr("data.altinitycloud_clickhouse_versions.stable", "versions.0.release", "24.8"),
+					resource.TestCheckResourceAttr("data.altinitycloud_clickhouse_versions.stable", "versions.0.lts", "true"),
+					resource.TestCheckResourceAttr("data.altinitycloud_clickhouse_versions.none", "versions.#", "0"),
+					resource.TestCheckNoResourceAttr("data.altinitycloud_clickhouse_versions.none", "latest"),
+				),
+			},
+		},
+	})
+}
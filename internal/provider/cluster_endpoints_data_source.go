@@ -0,0 +1,158 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/tatari-tv/terraform-provider-altinitycloud/cmd/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &clusterEndpointsDataSource{}
+	_ datasource.DataSourceWithConfigure = &clusterEndpointsDataSource{}
+)
+
+func NewClusterEndpointsDataSource() datasource.DataSource {
+	return &clusterEndpointsDataSource{}
+}
+
+// clusterEndpointsDataSource - defines the cluster endpoints data source implementation.
+type clusterEndpointsDataSource struct {
+	client *client.AltinityCloudClient
+}
+
+// Metadata - returns the altinitycloud_cluster_endpoints type name.
+func (d *clusterEndpointsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_endpoints"
+}
+
+// Schema - defines the cluster endpoints schema.
+func (d *clusterEndpointsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Returns the hosts, ports and CA certificate apps use to connect to an Altinity.Cloud cluster, " +
+			"e.g. to write them into a Kubernetes secret or Vault.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Altinity.Cloud cluster ID.",
+			},
+			"host": schema.StringAttribute{
+				Computed: true,
+				MarkdownDescription: "Load balanced host apps connect to. This is the private endpoint host when the environment " +
+					"has an available `altinitycloud_private_endpoint_service`, otherwise the same as `dns_name`.",
+			},
+			"dns_name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Public DNS name of the cluster, the replica hosts are named under it.",
+			},
+			"http_port": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Port of the ClickHouse HTTP interface.",
+			},
+			"https_port": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Port of the ClickHouse HTTP interface over TLS.",
+			},
+			"native_port": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Port of the ClickHouse native protocol.",
+			},
+			"native_tls_port": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Port of the ClickHouse native protocol over TLS.",
+			},
+			"replicas": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Hosts of the individual replicas, ordered by shard and replica.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"shard": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Shard number, starting at 0.",
+						},
+						"replica": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Replica number within the shard, starting at 0.",
+						},
+						"host": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Host of the replica.",
+						},
+					},
+				},
+			},
+			"ca_certificate": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "PEM encoded CA certificate that signed the TLS certificates of the cluster.",
+			},
+		},
+	}
+}
+
+// Configure - bootstraps cluster endpoints datasource with Altinity.Cloud client.
+func (d *clusterEndpointsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring cluster endpoints data source")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.AltinityCloudClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *altinityCloudClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read - returns the connection details of the cluster.
+func (d *clusterEndpointsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading cluster endpoints data source")
+	var state ClusterEndpointsDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ep, err := d.client.GetClusterEndpoints(ctx, state.ClusterID.ValueString())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Client Error", "Unable to read cluster endpoints", err, nil)
+		return
+	}
+
+	state.Host = types.StringValue(ep.Host)
+	state.DNSName = types.StringValue(ep.DNSName)
+	state.HTTPPort = types.Int64Value(ep.HTTPPort)
+	state.HTTPSPort = types.Int64Value(ep.HTTPSPort)
+	state.NativePort = types.Int64Value(ep.NativePort)
+	state.NativeTLSPort = types.Int64Value(ep.NativeTLSPort)
+	state.CACertificate = types.StringValue(ep.CACertificate)
+	state.Replicas = []ReplicaEndpointModel{}
+	for _, r := range ep.Replicas {
+		state.Replicas = append(state.Replicas, ReplicaEndpointModel{
+			Shard:   types.Int64Value(r.Shard),
+			Replica: types.Int64Value(r.Replica),
+			Host:    types.StringValue(r.Host),
+		})
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("found %d replica hosts of cluster %v", len(state.Replicas), state.ClusterID))
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
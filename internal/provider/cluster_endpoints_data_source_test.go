@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/tatari-tv/terraform-provider-altinitycloud/cmd/client"
+	"github.com/tatari-tv/terraform-provider-altinitycloud/internal/fakeacm"
+	"regexp"
+	"testing"
+)
+
+func TestAccClusterEndpointsDataSource(t *testing.T) {
+	s := fakeacm.NewServer(t)
+	s.AddEnvironment(client.Environment{ID: "648", Name: "prod", Cloud: "aws", Region: "us-east-1"})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(s) + `
+data "altinitycloud_cluster_endpoints" "test" {
+  cluster_id = "404"
+}
+`,
+				ExpectError: regexp.MustCompile(`Unable to read cluster endpoints`),
+			},
+			{
+				Config: testAccProviderConfig(s) + testAccClusterEndpointsDataSourceConfig(""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.altinitycloud_cluster_endpoints.test", "host", "tf-acc.prod.altinity.cloud"),
+					resource.TestCheckResourceAttr("data.altinitycloud_cluster_endpoints.test", "dns_name", "tf-acc.prod.altinity.cloud"),
+					resource.TestCheckResourceAttr("data.altinitycloud_cluster_endpoints.test", "http_port", "8123"),
+					resource.TestCheckResourceAttr("data.altinitycloud_cluster_endpoints.test", "https_port", "8443"),
+					resource.TestCheckResourceAttr("data.altinitycloud_cluster_endpoints.test", "native_port", "9000"),
+					resource.TestCheckResourceAttr("data.altinitycloud_cluster_endpoints.test", "native_tls_port", "9440"),
+					resource.TestCheckResourceAttr("data.altinitycloud_cluster_endpoints.test", "replicas.#", "4"),
+					resource.TestCheckResourceAttr("data.altinitycloud_cluster_endpoints.test", "replicas.3.shard", "1"),
+					resource.TestCheckResourceAttr("data.altinitycloud_cluster_endpoints.test", "replicas.3.replica", "1"),
+					resource.TestCheckResourceAttr("data.altinitycloud_cluster_endpoints.test", "replicas.3.host",
+						"chi-tf-acc-tf-acc-1-1.tf-acc.prod.altinity.cloud"),
+					resource.TestCheckResourceAttr("data.altinitycloud_cluster_endpoints.test", "ca_certificate", fakeacm.CACertificate),
+				),
+			},
+			// Apps connect through the private endpoint once the environment has one
+			{
+				Config: testAccProviderConfig(s) + testAccClusterEndpointsDataSourceConfig(`
+resource "altinitycloud_private_endpoint_service" "test" {
+  env_id = "648"
+}
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.altinitycloud_cluster_endpoints.test", "host", "tf-acc.prod.privatelink.altinity.cloud"),
+					resource.TestCheckResourceAttr("data.altinitycloud_cluster_endpoints.test", "dns_name", "tf-acc.prod.altinity.cloud"),
+				),
+			},
+		},
+	})
+}
+
+func testAccClusterEndpointsDataSourceConfig(privateEndpoint string) string {
+	dependsOn := "[]"
+	if privateEndpoint != "" {
+		dependsOn = "[altinitycloud_private_endpoint_service.test]"
+	}
+	return privateEndpoint + fmt.Sprintf(`
+resource "altinitycloud_cluster" "test" {
+  env_id         = "648"
+  name           = "tf-acc"
+  version        = "24.3.5.47.altinitystable"
+  node_type      = "m6i.xlarge"
+  shards         = 2
+  replicas       = 2
+  disk_size      = 100
+  admin_password = "admin-secret"
+}
+
+data "altinitycloud_cluster_endpoints" "test" {
+  cluster_id = altinitycloud_cluster.test.id
+  depends_on = %s
+}
+`, dependsOn)
+}
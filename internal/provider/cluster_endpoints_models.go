@@ -0,0 +1,23 @@
+package provider
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// ClusterEndpointsDataSourceModel - describes the connection details of a cluster for data sources.
+type ClusterEndpointsDataSourceModel struct {
+	ClusterID     types.String           `tfsdk:"cluster_id"`
+	Host          types.String           `tfsdk:"host"`
+	DNSName       types.String           `tfsdk:"dns_name"`
+	HTTPPort      types.Int64            `tfsdk:"http_port"`
+	HTTPSPort     types.Int64            `tfsdk:"https_port"`
+	NativePort    types.Int64            `tfsdk:"native_port"`
+	NativeTLSPort types.Int64            `tfsdk:"native_tls_port"`
+	Replicas      []ReplicaEndpointModel `tfsdk:"replicas"`
+	CACertificate types.String           `tfsdk:"ca_certificate"`
+}
+
+// ReplicaEndpointModel - replica host datasource representation.
+type ReplicaEndpointModel struct {
+	Shard   types.Int64  `tfsdk:"shard"`
+	Replica types.Int64  `tfsdk:"replica"`
+	Host    types.String `tfsdk:"host"`
+}
@@ -0,0 +1,18 @@
+package provider
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// ClusterIPAllowlistResourceModel - describes the cluster IP allowlist model for resources.
+type ClusterIPAllowlistResourceModel struct {
+	ID          types.String            `tfsdk:"id"`
+	ClusterID   types.String            `tfsdk:"cluster_id"`
+	Mode        types.String            `tfsdk:"mode"`
+	Entries     []IPAllowlistEntryModel `tfsdk:"entries"`
+	LastUpdated types.String            `tfsdk:"last_updated"`
+}
+
+// IPAllowlistEntryModel - describes a network allowed to connect to the cluster.
+type IPAllowlistEntryModel struct {
+	CIDR        types.String `tfsdk:"cidr"`
+	Description types.String `tfsdk:"description"`
+}
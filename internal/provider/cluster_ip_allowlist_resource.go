@@ -0,0 +1,412 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/tatari-tv/terraform-provider-altinitycloud/cmd/client"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// IP allowlist modes, merge only manages the listed entries and authoritative removes all others.
+const (
+	ipAllowlistModeMerge         = "merge"
+	ipAllowlistModeAuthoritative = "authoritative"
+)
+
+var ipAllowlistModes = []string{ipAllowlistModeMerge, ipAllowlistModeAuthoritative}
+
+// ipAllowlistMu - serializes allowlist changes, merge mode resources of the same cluster read and
+// replace the whole allowlist and would otherwise overwrite each other.
+var ipAllowlistMu sync.Mutex
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &clusterIPAllowlistResource{}
+	_ resource.ResourceWithConfigure      = &clusterIPAllowlistResource{}
+	_ resource.ResourceWithImportState    = &clusterIPAllowlistResource{}
+	_ resource.ResourceWithValidateConfig = &clusterIPAllowlistResource{}
+)
+
+// NewClusterIPAllowlistResource is a helper function to simplify the provider implementation.
+func NewClusterIPAllowlistResource() resource.Resource {
+	return &clusterIPAllowlistResource{}
+}
+
+// clusterIPAllowlistResource is the resource implementation.
+type clusterIPAllowlistResource struct {
+	client *client.AltinityCloudClient
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *clusterIPAllowlistResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.AltinityCloudClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Cluster IP Allowlist Source Configure Type",
+			fmt.Sprintf("Expected *client.AltinityCloudClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Metadata - returns the resource type name.
+func (r *clusterIPAllowlistResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_ip_allowlist"
+}
+
+// Schema - defines the schema for the resource.
+func (r *clusterIPAllowlistResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the networks allowed to connect to the endpoints of an Altinity.Cloud cluster. In `merge` mode only the " +
+			"listed entries are managed and entries added elsewhere are kept, in `authoritative` mode all other entries are removed. " +
+			"A cluster with an empty allowlist accepts connections from anywhere.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Cluster IP allowlist ID, same as `cluster_id`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cluster_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Altinity.Cloud cluster ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"mode": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "Either `merge` to only add and remove the listed entries, or `authoritative` to also remove every " +
+					"entry that is not listed. Destroying the resource removes the listed entries in `merge` mode and clears the allowlist " +
+					"in `authoritative` mode. Defaults to `merge`.",
+				Default: stringdefault.StaticString(ipAllowlistModeMerge),
+				Validators: []validator.String{
+					stringvalidator.OneOf(ipAllowlistModes...),
+				},
+			},
+			"entries": schema.SetNestedAttribute{
+				Required:            true,
+				MarkdownDescription: "Networks allowed to connect to the cluster.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"cidr": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "IP address or CIDR block, e.g. `203.0.113.0/24`. CIDR blocks must start at their network address.",
+							Validators: []validator.String{
+								ipOrCanonicalCIDR(),
+							},
+						},
+						"description": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "What the network is, e.g. `office VPN`.",
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+					},
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Cluster IP allowlist last updated timestamp. This is auto-generated by the provider.",
+			},
+		},
+	}
+}
+
+// ValidateConfig - checks that every network is listed once.
+func (r *clusterIPAllowlistResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var entries types.Set
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("entries"), &entries)...)
+	if resp.Diagnostics.HasError() || entries.IsNull() || entries.IsUnknown() {
+		return
+	}
+
+	seen := map[string]bool{}
+	for _, elem := range entries.Elements() {
+		entry, ok := elem.(types.Object)
+		if !ok || entry.IsUnknown() {
+			continue
+		}
+		cidr, ok := entry.Attributes()["cidr"].(types.String)
+		if !ok || cidr.IsUnknown() || cidr.IsNull() {
+			continue
+		}
+		if seen[cidr.ValueString()] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("entries"),
+				"Duplicate Allowlist Entry",
+				fmt.Sprintf("%s is listed more than once, each network can only have one description.", cidr.ValueString()),
+			)
+		}
+		seen[cidr.ValueString()] = true
+	}
+}
+
+// Create - adds the entries to the allowlist of the cluster and sets the initial Terraform state.
+func (r *clusterIPAllowlistResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating cluster IP allowlist resource")
+	// Retrieve values from plan
+	var plan ClusterIPAllowlistResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Failed to retrieve plan for Altinity.Cloud cluster IP allowlist resource")
+		return
+	}
+
+	// Set IP allowlist
+	tflog.Info(ctx, fmt.Sprintf("Setting %s IP allowlist of cluster %s", plan.Mode.ValueString(), plan.ClusterID.ValueString()))
+	allowlist, err := r.setIPAllowlist(ctx, plan.ClusterID.ValueString(), func(current []client.IPAllowlistEntry) []client.IPAllowlistEntry {
+		if plan.Mode.ValueString() == ipAllowlistModeAuthoritative {
+			return mapIPAllowlistModelToEntries(plan.Entries)
+		}
+		return mergeIPAllowlist(current, nil, mapIPAllowlistModelToEntries(plan.Entries))
+	})
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error creating cluster IP allowlist", "Could not set cluster IP allowlist", err, clusterIPAllowlistFieldPaths)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	mapIPAllowlistToIPAllowlistModel(allowlist.Entries, &plan)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	// Set plan to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *clusterIPAllowlistResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Read Altinity.Cloud cluster IP allowlist resource")
+	// Get current state
+	var state ClusterIPAllowlistResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed IP allowlist from Altinity.Cloud, entries may have been changed in the ACM UI
+	allowlist, err := r.client.GetClusterIPAllowlist(ctx, state.ClusterID.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, fmt.Sprintf("cluster %s not found, removing its IP allowlist from state", state.ClusterID.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error retrieving cluster IP allowlist", "Could not retrieve cluster IP allowlist", err, nil)
+		return
+	}
+
+	// Overwrite current state with refreshed data, changed and removed entries show up as drift
+	mapIPAllowlistToIPAllowlistModel(allowlist.Entries, &state)
+
+	tflog.Trace(ctx, fmt.Sprintf("refreshed IP allowlist of cluster %s from API", state.ClusterID.ValueString()))
+
+	// set refreshed state
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update - replaces the managed entries of the allowlist and sets the updated Terraform state on success.
+func (r *clusterIPAllowlistResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Update cluster IP allowlist resource")
+	// Retrieve values from plan and state
+	var plan, state ClusterIPAllowlistResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Failed to retrieve plan for Altinity.Cloud cluster IP allowlist resource")
+		return
+	}
+
+	// Update IP allowlist in Altinity.Cloud
+	tflog.Info(ctx, fmt.Sprintf("Updating %s IP allowlist of cluster %s", plan.Mode.ValueString(), plan.ClusterID.ValueString()))
+	allowlist, err := r.setIPAllowlist(ctx, plan.ClusterID.ValueString(), func(current []client.IPAllowlistEntry) []client.IPAllowlistEntry {
+		if plan.Mode.ValueString() == ipAllowlistModeAuthoritative {
+			return mapIPAllowlistModelToEntries(plan.Entries)
+		}
+		// entries an authoritative allowlist removed before stay unmanaged when switching to merge
+		var removed []client.IPAllowlistEntry
+		if state.Mode.ValueString() == ipAllowlistModeMerge {
+			removed = mapIPAllowlistModelToEntries(state.Entries)
+		}
+		return mergeIPAllowlist(current, removed, mapIPAllowlistModelToEntries(plan.Entries))
+	})
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error updating cluster IP allowlist", "Could not set cluster IP allowlist", err, clusterIPAllowlistFieldPaths)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	mapIPAllowlistToIPAllowlistModel(allowlist.Entries, &plan)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	// Set plan to fully populated data
+	diags := resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete - removes the managed entries, or all of them in authoritative mode, and removes the Terraform state on success.
+func (r *clusterIPAllowlistResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Delete cluster IP allowlist resource")
+	// Retrieve values from state
+	var state ClusterIPAllowlistResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Remove the entries, the cluster may already be gone
+	_, err := r.setIPAllowlist(ctx, state.ClusterID.ValueString(), func(current []client.IPAllowlistEntry) []client.IPAllowlistEntry {
+		if state.Mode.ValueString() == ipAllowlistModeAuthoritative {
+			return nil
+		}
+		return mergeIPAllowlist(current, mapIPAllowlistModelToEntries(state.Entries), nil)
+	})
+	if client.IsNotFound(err) {
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error deleting cluster IP allowlist", "Could not set cluster IP allowlist", err, nil)
+		return
+	}
+}
+
+// ImportState - imports the IP allowlist of a cluster by "<cluster_id>" in merge mode, or by
+// "<cluster_id>/<mode>". Either way every entry the cluster has is managed after the import.
+func (r *clusterIPAllowlistResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, "Import cluster IP allowlist resource")
+	clusterID, mode := req.ID, ipAllowlistModeMerge
+	if strings.Contains(req.ID, "/") {
+		parts, err := splitImportID(req.ID, 2, "<cluster_id> or <cluster_id>/<mode>")
+		if err != nil {
+			resp.Diagnostics.AddError("Unexpected Import Identifier", err.Error())
+			return
+		}
+		clusterID, mode = parts[0], parts[1]
+		if !slices.Contains(ipAllowlistModes, mode) {
+			resp.Diagnostics.AddError(
+				"Unexpected Import Identifier",
+				fmt.Sprintf("expected mode %s in import identifier, got: %q", strings.Join(ipAllowlistModes, " or "), mode),
+			)
+			return
+		}
+	}
+
+	// Save the cluster ID to id and cluster_id attributes, the entries are read by Read
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), clusterID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_id"), clusterID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("mode"), mode)...)
+}
+
+// setIPAllowlist - reads the current allowlist of the cluster and replaces it with the entries returned by change.
+func (r *clusterIPAllowlistResource) setIPAllowlist(ctx context.Context, clusterID string, change func([]client.IPAllowlistEntry) []client.IPAllowlistEntry) (client.IPAllowlistData, error) {
+	ipAllowlistMu.Lock()
+	defer ipAllowlistMu.Unlock()
+
+	current, err := r.client.GetClusterIPAllowlist(ctx, clusterID)
+	if err != nil {
+		return client.IPAllowlistData{}, err
+	}
+	return r.client.SetClusterIPAllowlist(ctx, clusterID, change(current.Entries))
+}
+
+// mergeIPAllowlist - returns the current entries without the removed ones, with the added entries
+// replacing current entries of the same network.
+func mergeIPAllowlist(current, removed, added []client.IPAllowlistEntry) []client.IPAllowlistEntry {
+	dropped := map[string]bool{}
+	for _, e := range removed {
+		dropped[e.CIDR] = true
+	}
+	for _, e := range added {
+		dropped[e.CIDR] = true
+	}
+
+	merged := []client.IPAllowlistEntry{}
+	for _, e := range current {
+		if !dropped[e.CIDR] {
+			merged = append(merged, e)
+		}
+	}
+	return append(merged, added...)
+}
+
+// mapIPAllowlistModelToEntries - converts the Terraform entries into API entries.
+func mapIPAllowlistModelToEntries(entries []IPAllowlistEntryModel) []client.IPAllowlistEntry {
+	result := []client.IPAllowlistEntry{}
+	for _, e := range entries {
+		result = append(result, client.IPAllowlistEntry{
+			CIDR:        e.CIDR.ValueString(),
+			Description: e.Description.ValueString(),
+		})
+	}
+	return result
+}
+
+// mapIPAllowlistToIPAllowlistModel - copies the API entries into the Terraform model. In merge mode
+// only the entries already in the model are kept, all entries in authoritative mode.
+func mapIPAllowlistToIPAllowlistModel(entries []client.IPAllowlistEntry, m *ClusterIPAllowlistResourceModel) {
+	managed := map[string]bool{}
+	for _, e := range m.Entries {
+		managed[e.CIDR.ValueString()] = true
+	}
+	// entries are only null right after an import, which manages every entry the cluster has
+	all := m.Entries == nil || m.Mode.ValueString() == ipAllowlistModeAuthoritative
+
+	m.ID = m.ClusterID
+	m.Entries = []IPAllowlistEntryModel{}
+	for _, e := range entries {
+		if !all && !managed[e.CIDR] {
+			continue
+		}
+		// the API does not return empty descriptions
+		description := types.StringNull()
+		if e.Description != "" {
+			description = types.StringValue(e.Description)
+		}
+		m.Entries = append(m.Entries, IPAllowlistEntryModel{
+			CIDR:        types.StringValue(e.CIDR),
+			Description: description,
+		})
+	}
+}
@@ -0,0 +1,206 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/tatari-tv/terraform-provider-altinitycloud/cmd/client"
+	"github.com/tatari-tv/terraform-provider-altinitycloud/internal/fakeacm"
+	"regexp"
+	"testing"
+)
+
+func TestMergeIPAllowlist(t *testing.T) {
+	current := []client.IPAllowlistEntry{
+		{CIDR: "10.0.0.0/8", Description: "vpc"},
+		{CIDR: "203.0.113.0/24", Description: "office"},
+		{CIDR: "198.51.100.7"},
+	}
+
+	merged := mergeIPAllowlist(current,
+		[]client.IPAllowlistEntry{{CIDR: "198.51.100.7"}},
+		[]client.IPAllowlistEntry{{CIDR: "203.0.113.0/24", Description: "office VPN"}, {CIDR: "192.0.2.0/24"}},
+	)
+	assert.Equal(t, []client.IPAllowlistEntry{
+		{CIDR: "10.0.0.0/8", Description: "vpc"},
+		{CIDR: "203.0.113.0/24", Description: "office VPN"},
+		{CIDR: "192.0.2.0/24"},
+	}, merged)
+
+	assert.Equal(t, []client.IPAllowlistEntry{}, mergeIPAllowlist(nil, current, nil))
+}
+
+func TestMapIPAllowlistToIPAllowlistModel(t *testing.T) {
+	entries := []client.IPAllowlistEntry{
+		{CIDR: "10.0.0.0/8", Description: "vpc"},
+		{CIDR: "203.0.113.0/24"},
+	}
+
+	// merge mode only keeps the entries it manages
+	m := ClusterIPAllowlistResourceModel{
+		ClusterID: types.StringValue("42"),
+		Mode:      types.StringValue(ipAllowlistModeMerge),
+		Entries:   []IPAllowlistEntryModel{{CIDR: types.StringValue("203.0.113.0/24")}, {CIDR: types.StringValue("192.0.2.0/24")}},
+	}
+	mapIPAllowlistToIPAllowlistModel(entries, &m)
+	assert.Equal(t, "42", m.ID.ValueString())
+	assert.Equal(t, []IPAllowlistEntryModel{{CIDR: types.StringValue("203.0.113.0/24"), Description: types.StringNull()}}, m.Entries)
+
+	// authoritative mode keeps all of them
+	m.Mode = types.StringValue(ipAllowlistModeAuthoritative)
+	mapIPAllowlistToIPAllowlistModel(entries, &m)
+	assert.Len(t, m.Entries, 2)
+	assert.Equal(t, "vpc", m.Entries[0].Description.ValueString())
+
+	// an imported allowlist in merge mode manages all of them
+	m = ClusterIPAllowlistResourceModel{ClusterID: types.StringValue("42"), Mode: types.StringValue(ipAllowlistModeMerge)}
+	mapIPAllowlistToIPAllowlistModel(entries, &m)
+	assert.Len(t, m.Entries, 2)
+}
+
+func TestAccClusterIPAllowlistResource(t *testing.T) {
+	s := fakeacm.NewServer(t)
+	var clusterID string
+	ui := client.IPAllowlistEntry{CIDR: "198.51.100.7", Description: "added in the UI"}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Networks are validated during plan
+			{
+				Config:      testAccProviderConfig(s) + testAccClusterIPAllowlistResourceConfig("merge", `{ cidr = "10.0.0.1/8" }`),
+				ExpectError: regexp.MustCompile(`must be written as its network address 10.0.0.0/8`),
+			},
+			{
+				Config: testAccProviderConfig(s) + testAccClusterIPAllowlistResourceConfig("merge",
+					`{ cidr = "10.0.0.0/8", description = "vpc" }, { cidr = "10.0.0.0/8", description = "peering" }`),
+				ExpectError: regexp.MustCompile(`10.0.0.0/8 is listed more than once`),
+			},
+			// Create the cluster with an entry managed outside Terraform
+			{
+				Config: testAccProviderConfig(s) + testAccClusterResourceConfig("admin-secret"),
+				Check:  testAccCaptureID("altinitycloud_cluster.test", &clusterID),
+			},
+			// Merge mode keeps the entry added in the UI
+			{
+				PreConfig: func() { s.SetIPAllowlist(clusterID, []client.IPAllowlistEntry{ui}) },
+				Config:    testAccProviderConfig(s) + testAccClusterIPAllowlistResourceConfig("merge", `{ cidr = "10.0.0.0/8", description = "vpc" }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPtr("altinitycloud_cluster_ip_allowlist.test", "id", &clusterID),
+					resource.TestCheckResourceAttr("altinitycloud_cluster_ip_allowlist.test", "mode", "merge"),
+					resource.TestCheckResourceAttr("altinitycloud_cluster_ip_allowlist.test", "entries.#", "1"),
+					testAccCheckIPAllowlist(s, &clusterID, "198.51.100.7", "10.0.0.0/8"),
+				),
+			},
+			// Managed entries changed outside Terraform are planned back
+			{
+				PreConfig: func() {
+					s.SetIPAllowlist(clusterID, []client.IPAllowlistEntry{ui, {CIDR: "10.0.0.0/8", Description: "changed"}})
+				},
+				Config:             testAccProviderConfig(s) + testAccClusterIPAllowlistResourceConfig("merge", `{ cidr = "10.0.0.0/8", description = "vpc" }`),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			// Replacing the managed entries keeps the unmanaged one
+			{
+				Config: testAccProviderConfig(s) + testAccClusterIPAllowlistResourceConfig("merge", `{ cidr = "203.0.113.0/24" }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("altinitycloud_cluster_ip_allowlist.test", "entries.#", "1"),
+					testAccCheckIPAllowlist(s, &clusterID, "198.51.100.7", "203.0.113.0/24"),
+				),
+			},
+			// Authoritative mode removes everything else
+			{
+				Config: testAccProviderConfig(s) + testAccClusterIPAllowlistResourceConfig("authoritative", `{ cidr = "203.0.113.0/24" }`),
+				Check:  testAccCheckIPAllowlist(s, &clusterID, "203.0.113.0/24"),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "altinitycloud_cluster_ip_allowlist.test",
+				ImportState:             true,
+				ImportStateIdFunc:       func(*terraform.State) (string, error) { return clusterID + "/authoritative", nil },
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			// Entries added outside Terraform are planned for removal
+			{
+				PreConfig: func() {
+					s.SetIPAllowlist(clusterID, []client.IPAllowlistEntry{ui, {CIDR: "203.0.113.0/24"}})
+				},
+				Config:             testAccProviderConfig(s) + testAccClusterIPAllowlistResourceConfig("authoritative", `{ cidr = "203.0.113.0/24" }`),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccClusterIPAllowlistResourceImport(t *testing.T) {
+	s := fakeacm.NewServer(t)
+	var clusterID string
+	config := testAccProviderConfig(s) + testAccClusterIPAllowlistResourceConfig("merge", `{ cidr = "10.0.0.0/8", description = "vpc" }, { cidr = "203.0.113.0/24" }`)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(s) + testAccClusterResourceConfig("admin-secret"),
+				Check:  testAccCaptureID("altinitycloud_cluster.test", &clusterID),
+			},
+			// The cluster ID alone imports every entry in the default merge mode
+			{
+				PreConfig: func() {
+					s.SetIPAllowlist(clusterID, []client.IPAllowlistEntry{{CIDR: "10.0.0.0/8", Description: "vpc"}, {CIDR: "203.0.113.0/24"}})
+				},
+				Config:             config,
+				ResourceName:       "altinitycloud_cluster_ip_allowlist.test",
+				ImportState:        true,
+				ImportStateIdFunc:  func(*terraform.State) (string, error) { return clusterID, nil },
+				ImportStatePersist: true,
+			},
+			// The imported state matches the configuration without a follow-up diff
+			{
+				Config:   config,
+				PlanOnly: true,
+			},
+			{
+				ResourceName:            "altinitycloud_cluster_ip_allowlist.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			{
+				ResourceName:  "altinitycloud_cluster_ip_allowlist.test",
+				ImportState:   true,
+				ImportStateId: "42/strict",
+				ExpectError:   regexp.MustCompile(`expected mode merge or authoritative in import identifier`),
+			},
+		},
+	})
+}
+
+// testAccCheckIPAllowlist - checks the networks the fake API stored for the test cluster.
+func testAccCheckIPAllowlist(s *fakeacm.Server, clusterID *string, want ...string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		var got []string
+		for _, e := range s.IPAllowlist(*clusterID) {
+			got = append(got, e.CIDR)
+		}
+		if fmt.Sprint(got) != fmt.Sprint(want) {
+			return fmt.Errorf("IP allowlist of cluster %s is %v, want %v", *clusterID, got, want)
+		}
+		return nil
+	}
+}
+
+func testAccClusterIPAllowlistResourceConfig(mode, entries string) string {
+	return testAccClusterResourceConfig("admin-secret") + fmt.Sprintf(`
+resource "altinitycloud_cluster_ip_allowlist" "test" {
+  cluster_id = altinitycloud_cluster.test.id
+  mode       = %q
+  entries    = [%s]
+}
+`, mode, entries)
+}
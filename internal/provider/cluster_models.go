@@ -0,0 +1,33 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ClusterResourceModel - describes the ClickHouse cluster model for resources.
+type ClusterResourceModel struct {
+	ID             types.String   `tfsdk:"id"`
+	EnvID          types.String   `tfsdk:"env_id"`
+	Name           types.String   `tfsdk:"name"`
+	Version        types.String   `tfsdk:"version"`
+	NodeType       types.String   `tfsdk:"node_type"`
+	Shards         types.Int64    `tfsdk:"shards"`
+	Replicas       types.Int64    `tfsdk:"replicas"`
+	DiskSize       types.Int64    `tfsdk:"disk_size"`
+	Zookeeper      types.String   `tfsdk:"zookeeper"`
+	AdminUser      types.String   `tfsdk:"admin_user"`
+	AdminPassword  types.String   `tfsdk:"admin_password"`
+	AllowDowngrade types.Bool     `tfsdk:"allow_downgrade"`
+	RestoreFrom    types.Object   `tfsdk:"restore_from"`
+	Status         types.String   `tfsdk:"status"`
+	LastUpdated    types.String   `tfsdk:"last_updated"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
+}
+
+// RestoreFromModel - describes the backup a new cluster is restored from.
+type RestoreFromModel struct {
+	SourceClusterID types.String `tfsdk:"source_cluster_id"`
+	BackupName      types.String `tfsdk:"backup_name"`
+	BackupTimestamp types.String `tfsdk:"backup_timestamp"`
+}
@@ -0,0 +1,13 @@
+package provider
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// ClusterProfileResourceModel - describes the ClickHouse settings profile model for resources.
+type ClusterProfileResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	ClusterID   types.String `tfsdk:"cluster_id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Settings    types.Map    `tfsdk:"settings"`
+	LastUpdated types.String `tfsdk:"last_updated"`
+}
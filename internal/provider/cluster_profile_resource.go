@@ -0,0 +1,313 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/tatari-tv/terraform-provider-altinitycloud/cmd/client"
+	"time"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &clusterProfileResource{}
+	_ resource.ResourceWithConfigure   = &clusterProfileResource{}
+	_ resource.ResourceWithImportState = &clusterProfileResource{}
+)
+
+// NewClusterProfileResource is a helper function to simplify the provider implementation.
+func NewClusterProfileResource() resource.Resource {
+	return &clusterProfileResource{}
+}
+
+// clusterProfileResource is the resource implementation.
+type clusterProfileResource struct {
+	client *client.AltinityCloudClient
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *clusterProfileResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.AltinityCloudClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Cluster Profile Source Configure Type",
+			fmt.Sprintf("Expected *client.AltinityCloudClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Metadata - returns the resource type name.
+func (r *clusterProfileResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_profile"
+}
+
+// Schema - defines the schema for the resource.
+func (r *clusterProfileResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a ClickHouse settings profile of an Altinity.Cloud cluster. Cluster users refer to it by `name`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Altinity.Cloud cluster profile ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cluster_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Altinity.Cloud cluster ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "ClickHouse settings profile name.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Description of the profile.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"settings": schema.MapAttribute{
+				Required:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "ClickHouse settings of the profile by name, e.g. `max_memory_usage = \"10000000000\"` or `readonly = \"1\"`.",
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+					mapvalidator.KeysAre(
+						stringvalidator.RegexMatches(clickHouseSettingRegexp, "must be a ClickHouse setting name, e.g. `max_memory_usage`"),
+					),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Altinity.Cloud cluster profile last updated timestamp. This is auto-generated by the provider.",
+			},
+		},
+	}
+}
+
+// Create - creates the cluster profile and sets the initial Terraform state.
+func (r *clusterProfileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating cluster profile resource")
+	// Retrieve values from plan
+	var plan ClusterProfileResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Failed to retrieve plan for Altinity.Cloud cluster profile resource")
+		return
+	}
+
+	reqData, diags := mapClusterProfileModelToClusterProfile(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create new cluster profile
+	tflog.Info(ctx, fmt.Sprintf("Creating profile %s in cluster %s", plan.Name.ValueString(), plan.ClusterID.ValueString()))
+	profile, err := r.client.CreateClusterProfile(ctx, plan.ClusterID.ValueString(), reqData)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error creating cluster profile", "Could not create cluster profile", err, clusterProfileFieldPaths)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	mapClusterProfileToClusterProfileModel(profile, &plan)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	// Set plan to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *clusterProfileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Read Altinity.Cloud cluster profile resource")
+	// Get current state
+	var state ClusterProfileResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed cluster profile from Altinity.Cloud
+	profile, err := r.client.GetClusterProfile(ctx, state.ID.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, fmt.Sprintf("cluster profile %s not found, removing it from state", state.ID.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error retrieving cluster profile", "Could not retrieve cluster profile", err, nil)
+		return
+	}
+
+	// Overwrite current state with refreshed data
+	mapClusterProfileToClusterProfileModel(profile, &state)
+
+	tflog.Trace(ctx, fmt.Sprintf("refreshed cluster profile %s from API", state.ID.ValueString()))
+
+	// set refreshed state
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update - updates the cluster profile and sets the updated Terraform state on success.
+// The API replaces all settings of the profile, so settings removed from the map are reset to their defaults.
+func (r *clusterProfileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Update cluster profile resource")
+	// Retrieve values from plan
+	var plan ClusterProfileResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Failed to retrieve plan for Altinity.Cloud cluster profile resource")
+		return
+	}
+
+	reqData, diags := mapClusterProfileModelToClusterProfile(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update cluster profile in Altinity.Cloud
+	tflog.Info(ctx, fmt.Sprintf("Updating cluster profile %s", plan.ID.ValueString()))
+	profile, err := r.client.UpdateClusterProfile(ctx, reqData)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error updating cluster profile", "Could not update cluster profile", err, clusterProfileFieldPaths)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	mapClusterProfileToClusterProfileModel(profile, &plan)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	// Set plan to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete - deletes the cluster profile and removes the Terraform state on success.
+func (r *clusterProfileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Delete cluster profile resource")
+	// Retrieve values from state
+	var state ClusterProfileResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing cluster profile, it may already be gone
+	err := r.client.DeleteClusterProfile(ctx, state.ID.ValueString())
+	if client.IsNotFound(err) {
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error deleting cluster profile", "Could not delete cluster profile", err, nil)
+		return
+	}
+}
+
+// ImportState - imports a cluster profile by "<cluster_id>/<profile_name>".
+func (r *clusterProfileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, "Import cluster profile resource")
+	parts, err := splitImportID(req.ID, 2, "<cluster_id>/<profile_name>")
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", err.Error())
+		return
+	}
+	clusterID, name := parts[0], parts[1]
+
+	// Look up the profile by name, the API only addresses profiles by ID
+	pd, err := r.client.GetClusterProfiles(ctx, clusterID)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error importing cluster profile", "Could not retrieve cluster profiles", err, nil)
+		return
+	}
+
+	for _, p := range pd.Profiles {
+		if p.Name == name {
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), p.ID)...)
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_id"), clusterID)...)
+			return
+		}
+	}
+
+	resp.Diagnostics.AddError(
+		"Error importing cluster profile",
+		fmt.Sprintf("Profile %s does not exist in cluster %s.", name, clusterID),
+	)
+}
+
+// mapClusterProfileModelToClusterProfile - converts the Terraform model into an API request.
+func mapClusterProfileModelToClusterProfile(ctx context.Context, m ClusterProfileResourceModel) (client.ClusterProfile, diag.Diagnostics) {
+	profile := client.ClusterProfile{
+		ID:          m.ID.ValueString(),
+		ClusterID:   m.ClusterID.ValueString(),
+		Name:        m.Name.ValueString(),
+		Description: m.Description.ValueString(),
+	}
+
+	var diags diag.Diagnostics
+	if !m.Settings.IsNull() && !m.Settings.IsUnknown() {
+		diags.Append(m.Settings.ElementsAs(ctx, &profile.Settings, false)...)
+	}
+
+	return profile, diags
+}
+
+// mapClusterProfileToClusterProfileModel - copies the API response into the Terraform model.
+func mapClusterProfileToClusterProfileModel(profile client.ClusterProfile, m *ClusterProfileResourceModel) {
+	m.ID = types.StringValue(profile.ID)
+	m.Name = types.StringValue(profile.Name)
+	m.Settings = mapStringMap(profile.Settings)
+
+	m.Description = types.StringNull()
+	if len(profile.Description) > 0 {
+		m.Description = types.StringValue(profile.Description)
+	}
+
+	// the cluster is only known from the API after an import
+	if len(profile.ClusterID) > 0 {
+		m.ClusterID = types.StringValue(profile.ClusterID)
+	}
+}
@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/tatari-tv/terraform-provider-altinitycloud/cmd/client"
+	"github.com/tatari-tv/terraform-provider-altinitycloud/internal/fakeacm"
+	"regexp"
+	"testing"
+)
+
+func TestMapClusterProfileToClusterProfileModel(t *testing.T) {
+	ctx := context.Background()
+	p := client.ClusterProfile{
+		ID:        "5",
+		ClusterID: "42",
+		Name:      "tenant",
+		Settings:  map[string]string{"max_memory_usage": "10000000000", "readonly": "1"},
+	}
+
+	m := ClusterProfileResourceModel{}
+	mapClusterProfileToClusterProfileModel(p, &m)
+	assert.Equal(t, "42", m.ClusterID.ValueString())
+	assert.True(t, m.Description.IsNull())
+	assert.Equal(t, types.StringValue("1"), m.Settings.Elements()["readonly"])
+
+	req, diags := mapClusterProfileModelToClusterProfile(ctx, m)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, p.Settings, req.Settings)
+}
+
+func TestAccClusterProfileResource(t *testing.T) {
+	s := fakeacm.NewServer(t)
+	var profileID string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Setting names are validated during plan
+			{
+				Config:      testAccProviderConfig(s) + testAccClusterProfileResourceConfig(`"Max-Memory" = "1"`),
+				ExpectError: regexp.MustCompile(`must be a ClickHouse setting name`),
+			},
+			// Create and Read testing, users refer to the profile by name
+			{
+				Config: testAccProviderConfig(s) + testAccClusterProfileResourceConfig(`max_memory_usage = "10000000000"
+    readonly         = "1"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("altinitycloud_cluster_profile.test", "id"),
+					resource.TestCheckResourceAttr("altinitycloud_cluster_profile.test", "settings.%", "2"),
+					resource.TestCheckResourceAttr("altinitycloud_cluster_profile.test", "settings.readonly", "1"),
+					resource.TestCheckResourceAttr("altinitycloud_cluster_user.test", "profile", "tenant"),
+					testAccCaptureID("altinitycloud_cluster_profile.test", &profileID),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "altinitycloud_cluster_profile.test",
+				ImportState:             true,
+				ImportStateIdFunc:       testAccClusterProfileImportID,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			// Settings changed outside Terraform are detected by Read
+			{
+				PreConfig: func() { s.SetClusterProfileSetting(profileID, "max_execution_time", "60") },
+				Config: testAccProviderConfig(s) + testAccClusterProfileResourceConfig(`max_memory_usage = "10000000000"
+    readonly         = "1"`),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			// Update settings in place
+			{
+				Config: testAccProviderConfig(s) + testAccClusterProfileResourceConfig(`max_memory_usage = "20000000000"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPtr("altinitycloud_cluster_profile.test", "id", &profileID),
+					resource.TestCheckResourceAttr("altinitycloud_cluster_profile.test", "settings.%", "1"),
+					testAccCheckClusterProfileSettings(s, &profileID, map[string]string{"max_memory_usage": "20000000000"}),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckClusterProfileSettings - checks the settings the fake API stored for the profile.
+func testAccCheckClusterProfileSettings(s *fakeacm.Server, ID *string, want map[string]string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		p, ok := s.ClusterProfile(*ID)
+		if !ok {
+			return fmt.Errorf("cluster profile %s not found in the API", *ID)
+		}
+		if !assert.ObjectsAreEqual(want, p.Settings) {
+			return fmt.Errorf("cluster profile settings are %v, want %v", p.Settings, want)
+		}
+		return nil
+	}
+}
+
+// testAccClusterProfileImportID - builds the "<cluster_id>/<profile_name>" import ID.
+func testAccClusterProfileImportID(st *terraform.State) (string, error) {
+	rs, ok := st.RootModule().Resources["altinitycloud_cluster_profile.test"]
+	if !ok {
+		return "", fmt.Errorf("altinitycloud_cluster_profile.test not found in state")
+	}
+	return rs.Primary.Attributes["cluster_id"] + "/" + rs.Primary.Attributes["name"], nil
+}
+
+func testAccClusterProfileResourceConfig(settings string) string {
+	return fmt.Sprintf(`
+resource "altinitycloud_cluster" "test" {
+  env_id         = "648"
+  name           = "tf-acc"
+  version        = "24.3.5.47.altinitystable"
+  node_type      = "m6i.xlarge"
+  disk_size      = 100
+  admin_password = "admin-secret"
+}
+
+resource "altinitycloud_cluster_profile" "test" {
+  cluster_id = altinitycloud_cluster.test.id
+  name       = "tenant"
+  settings = {
+    %s
+  }
+}
+
+resource "altinitycloud_cluster_user" "test" {
+  cluster_id = altinitycloud_cluster.test.id
+  username   = "tenant"
+  password   = "secret"
+  profile    = altinitycloud_cluster_profile.test.name
+}
+`, settings)
+}
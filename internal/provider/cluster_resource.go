@@ -0,0 +1,640 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/tatari-tv/terraform-provider-altinitycloud/cmd/client"
+	"time"
+)
+
+const (
+	// clusterLaunchTimeout - how long to wait for a cluster to come online.
+	clusterLaunchTimeout = 60 * time.Minute
+	// clusterUpdateTimeout - how long to wait for an updated cluster, rescales replace nodes one at a time.
+	clusterUpdateTimeout = 120 * time.Minute
+	// clusterDeleteTimeout - how long to wait for a deleted cluster to be gone.
+	clusterDeleteTimeout = 30 * time.Minute
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &clusterResource{}
+	_ resource.ResourceWithConfigure   = &clusterResource{}
+	_ resource.ResourceWithImportState = &clusterResource{}
+	_ resource.ResourceWithModifyPlan  = &clusterResource{}
+)
+
+// NewClusterResource is a helper function to simplify the provider implementation.
+func NewClusterResource() resource.Resource {
+	return &clusterResource{}
+}
+
+// clusterResource is the resource implementation.
+type clusterResource struct {
+	client *client.AltinityCloudClient
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *clusterResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.AltinityCloudClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Cluster Source Configure Type",
+			fmt.Sprintf("Expected *client.AltinityCloudClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Metadata - returns the resource type name.
+func (r *clusterResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster"
+}
+
+// Schema - defines the schema for the resource.
+func (r *clusterResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Altinity.Cloud cluster ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"env_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Altinity.Cloud environment ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "ClickHouse cluster name.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"version": schema.StringAttribute{
+				Required: true,
+				MarkdownDescription: "ClickHouse server version (e.g. `24.3.5.47.altinitystable`). Changing it upgrades the cluster in place with a rolling upgrade, " +
+					"see the `altinitycloud_clickhouse_versions` data source for available versions.",
+			},
+			"node_type": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of the Altinity.Cloud node type used by the ClickHouse nodes. Changing it rescales the cluster with a rolling restart.",
+			},
+			"shards": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Number of ClickHouse shards. Defaults to `1`. Changing it rescales the cluster in place, existing data is not rebalanced automatically.",
+				Default:             int64default.StaticInt64(1),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"replicas": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Number of replicas per shard. Defaults to `1`. Changing it rescales the cluster in place, new replicas copy the data of their shard.",
+				Default:             int64default.StaticInt64(1),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"disk_size": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "Data volume size per ClickHouse node in GB. Growing it rescales the cluster with a rolling restart, shrinking it replaces the cluster.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplaceIf(
+						diskShrinkRequiresReplace,
+						"Data volumes cannot shrink, so a smaller disk_size replaces the cluster.",
+						"Data volumes cannot shrink, so a smaller `disk_size` replaces the cluster.",
+					),
+				},
+			},
+			"zookeeper": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "ZooKeeper to use, either `launch` to launch a dedicated ensemble or the name of an existing one. Defaults to `launch`.",
+				Default:             stringdefault.StaticString("launch"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"admin_user": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "ClickHouse admin user name. Defaults to `admin`.",
+				Default:             stringdefault.StaticString("admin"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"admin_password": schema.StringAttribute{
+				Required:            true,
+				Sensitive:           true,
+				MarkdownDescription: "ClickHouse admin user password. After an import the configured password is only saved to state and the live one is kept, change it afterwards to reset the live password.",
+			},
+			"allow_downgrade": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Allow changing `version` to an older ClickHouse version. Downgrades are rejected during plan unless this is `true`. Defaults to `false`.",
+				Default:             booldefault.StaticBool(false),
+			},
+			"restore_from": schema.SingleNestedAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "Backup to restore into the cluster after it is launched, e.g. to clone production into staging. " +
+					"Only used when the cluster is created, later changes are ignored.",
+				Attributes: map[string]schema.Attribute{
+					"source_cluster_id": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "Altinity.Cloud ID of the cluster the backup was taken from.",
+					},
+					"backup_name": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "Name of the backup to restore. Exactly one of `backup_name` or `backup_timestamp` must be set.",
+						Validators: []validator.String{
+							stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("backup_timestamp")),
+						},
+					},
+					"backup_timestamp": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "Restore the latest backup taken at or before this RFC 3339 timestamp, e.g. `2024-05-01T03:00:00Z`.",
+						Validators: []validator.String{
+							rfc3339(),
+						},
+					},
+				},
+				PlanModifiers: []planmodifier.Object{
+					restoreFromPlanModifier{},
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Altinity.Cloud cluster status. This is auto-generated by the provider.",
+			},
+			"last_updated": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Altinity.Cloud cluster last updated timestamp. This is auto-generated by the provider.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Create - launches the cluster, waits for it to come online and restores a backup into it when requested.
+func (r *clusterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating cluster resource")
+	// Retrieve values from plan
+	var plan ClusterResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Failed to retrieve plan for Altinity.Cloud cluster resource")
+		return
+	}
+
+	// Launching and restoring the cluster share the create timeout
+	createTimeout, diags := plan.Timeouts.Create(ctx, clusterLaunchTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Look up the backup before launching, so a missing backup does not leave an empty cluster behind
+	var backup client.Backup
+	if !plan.RestoreFrom.IsNull() {
+		backup, diags = r.resolveRestoreBackup(ctx, plan.RestoreFrom)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Launch new cluster
+	tflog.Info(ctx, fmt.Sprintf("Launching cluster %s in environment ID %s", plan.Name.ValueString(), plan.EnvID.ValueString()))
+	cluster, err := r.client.CreateCluster(ctx, plan.EnvID.ValueString(), mapClusterModelToCluster(plan))
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error creating cluster", "Could not launch cluster", err, clusterFieldPaths)
+		return
+	}
+
+	// Wait for the cluster to come online, a cluster that did not is saved to state and tainted
+	// so it is not orphaned by a timeout or an interrupted apply
+	waited, err := r.client.WaitForCluster(ctx, cluster.ID, client.ClusterStatusOnline)
+	if waited.ID != "" {
+		cluster = waited
+	}
+	if err != nil {
+		addWaitError(&resp.Diagnostics, "Error waiting for cluster", "Cluster "+cluster.ID+" did not come online", err)
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	mapClusterToClusterModel(cluster, &plan)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	// Set plan to fully populated data
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the cluster is saved to state first, a failed restore taints it
+	if backup.ID != "" {
+		resp.Diagnostics.Append(r.restoreCluster(ctx, cluster.ID, backup)...)
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *clusterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Read Altinity.Cloud cluster resource")
+	// Get current state
+	var state ClusterResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed cluster from Altinity.Cloud
+	cluster, err := r.client.GetCluster(ctx, state.ID.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, fmt.Sprintf("cluster %s not found, removing it from state", state.ID.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error retrieving cluster", "Could not retrieve cluster", err, nil)
+		return
+	}
+
+	// Overwrite current state with refreshed data
+	mapClusterToClusterModel(cluster, &state)
+
+	tflog.Trace(ctx, fmt.Sprintf("refreshed cluster %s from API", state.ID.ValueString()))
+
+	// set refreshed state
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update - rescales and updates the cluster in place and sets the updated Terraform state once it is online again.
+func (r *clusterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Update cluster resource")
+	// Retrieve values from plan and state
+	var plan, state ClusterResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Failed to retrieve plan for Altinity.Cloud cluster resource")
+		return
+	}
+
+	// Rescaling and updating the cluster share the update timeout
+	updateTimeout, diags := plan.Timeouts.Update(ctx, clusterUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// only timeouts or allow_downgrade may have changed, in which case the cluster is kept as is
+	desired, current := mapClusterModelToCluster(plan), mapClusterModelToCluster(state)
+	cluster := current
+	cluster.Status = state.Status.ValueString()
+
+	// an imported cluster has no admin password in state, the configured one is taken over as the
+	// current password instead of resetting the live admin password on the first apply
+	if state.AdminPassword.IsNull() {
+		current.AdminPassword = desired.AdminPassword
+		state.AdminPassword = plan.AdminPassword
+	}
+
+	// the new admin password is saved once it is sent, a failed wait before keeps the old one in state
+	adminPassword := plan.AdminPassword
+	plan.AdminPassword = state.AdminPassword
+
+	// Rescale the cluster when its size changed
+	if clusterScaleChanged(current, desired) {
+		tflog.Info(ctx, fmt.Sprintf("Rescaling cluster %s to %d shards, %d replicas of %s with %d GB disks",
+			plan.ID.ValueString(), desired.Shards, desired.Replicas, desired.NodeType, desired.DiskSize))
+		_, err := r.client.RescaleCluster(ctx, desired)
+		if err != nil {
+			addClientError(&resp.Diagnostics, "Error rescaling cluster", "Could not rescale cluster", err, clusterFieldPaths)
+			return
+		}
+
+		// Wait for the rolling update to finish before changing anything else
+		if !r.waitForUpdate(ctx, &cluster, &plan, resp, "did not come back online after rescaling") {
+			return
+		}
+	}
+
+	// Upgrade ClickHouse when the version changed
+	if desired.Version != current.Version {
+		tflog.Info(ctx, fmt.Sprintf("Upgrading cluster %s from ClickHouse %s to %s", plan.ID.ValueString(), current.Version, desired.Version))
+		_, err := r.client.UpgradeCluster(ctx, desired.ID, desired.Version, plan.AllowDowngrade.ValueBool())
+		if err != nil {
+			addClientError(&resp.Diagnostics, "Error upgrading cluster", "Could not upgrade cluster", err, clusterFieldPaths)
+			return
+		}
+
+		// Wait for the rolling upgrade to finish
+		if !r.waitForUpdate(ctx, &cluster, &plan, resp, "did not come back online after upgrading") {
+			return
+		}
+	}
+
+	// Update the remaining attributes in Altinity.Cloud
+	if desired.AdminPassword != current.AdminPassword {
+		tflog.Info(ctx, fmt.Sprintf("Updating cluster %s", plan.ID.ValueString()))
+		_, err := r.client.UpdateCluster(ctx, desired)
+		if err != nil {
+			addClientError(&resp.Diagnostics, "Error updating cluster", "Could not update cluster", err, clusterFieldPaths)
+			return
+		}
+		plan.AdminPassword = adminPassword
+
+		// Wait for the cluster to settle
+		if !r.waitForUpdate(ctx, &cluster, &plan, resp, "did not come back online") {
+			return
+		}
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	mapClusterToClusterModel(cluster, &plan)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	// Set plan to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete - deletes the cluster and removes the Terraform state on success.
+func (r *clusterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Delete cluster resource")
+	// Retrieve values from state
+	var state ClusterResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, clusterDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete existing cluster, it may already be gone
+	err := r.client.DeleteCluster(ctx, state.ID.ValueString())
+	if client.IsNotFound(err) {
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error deleting cluster", "Could not delete cluster", err, nil)
+		return
+	}
+
+	// Wait for the nodes to be torn down, an interrupted delete keeps the cluster in state
+	if err := r.client.WaitForClusterDeleted(ctx, state.ID.ValueString()); err != nil {
+		addWaitError(&resp.Diagnostics, "Error deleting cluster", "Cluster "+state.ID.ValueString()+" was not deleted", err)
+	}
+}
+
+// ModifyPlan - warns about disruptive rescales and plans no changes when only ignored attributes
+// like restore_from differ from the state.
+func (r *clusterResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// nothing to compare on create and destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state ClusterResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(rescaleWarnings(plan, state)...)
+	resp.Diagnostics.Append(upgradeDiagnostics(plan, state)...)
+	if !req.Config.Raw.IsFullyKnown() {
+		return
+	}
+
+	// status and last_updated are marked unknown as soon as the config differs from the state
+	if mapClusterModelToCluster(plan) == mapClusterModelToCluster(state) && plan.EnvID.Equal(state.EnvID) &&
+		plan.AllowDowngrade.Equal(state.AllowDowngrade) && plan.RestoreFrom.Equal(state.RestoreFrom) && plan.Timeouts.Equal(state.Timeouts) {
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, state)...)
+	}
+}
+
+// ImportState - imports an existing cluster by its Altinity.Cloud ID.
+func (r *clusterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, "Import cluster resource")
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("allow_downgrade"), false)...)
+}
+
+// waitForUpdate - waits for the cluster to come back online after a change. When it does not, the
+// last cluster read is saved to state, so the next plan shows the changes that are still missing.
+func (r *clusterResource) waitForUpdate(ctx context.Context, cluster *client.Cluster, plan *ClusterResourceModel, resp *resource.UpdateResponse, detail string) bool {
+	waited, err := r.client.WaitForCluster(ctx, plan.ID.ValueString(), client.ClusterStatusOnline)
+	if waited.ID != "" {
+		*cluster = waited
+	}
+	if err == nil {
+		return true
+	}
+
+	addWaitError(&resp.Diagnostics, "Error waiting for cluster", "Cluster "+plan.ID.ValueString()+" "+detail, err)
+	mapClusterToClusterModel(*cluster, plan)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	return false
+}
+
+// clusterReplaced - reports whether the plan replaces the cluster rather than updating it in place.
+func clusterReplaced(plan, state ClusterResourceModel) bool {
+	return !plan.EnvID.Equal(state.EnvID) || !plan.Name.Equal(state.Name) ||
+		!plan.Zookeeper.Equal(state.Zookeeper) || !plan.AdminUser.Equal(state.AdminUser) ||
+		(!plan.DiskSize.IsUnknown() && plan.DiskSize.ValueInt64() < state.DiskSize.ValueInt64())
+}
+
+// rescaleWarnings - describes the rolling restarts and data movement an in-place rescale causes.
+// Nothing is reported when the cluster is replaced instead.
+func rescaleWarnings(plan, state ClusterResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if clusterReplaced(plan, state) {
+		return diags
+	}
+
+	if !plan.NodeType.IsUnknown() && !plan.NodeType.Equal(state.NodeType) {
+		diags.AddAttributeWarning(
+			path.Root("node_type"),
+			"Cluster is restarted",
+			fmt.Sprintf("Changing node_type from %s to %s moves the ClickHouse nodes to the new node type one at a time "+
+				"with a rolling restart. Each replica is unavailable while it restarts.", state.NodeType.ValueString(), plan.NodeType.ValueString()),
+		)
+	}
+	if !plan.DiskSize.IsUnknown() && plan.DiskSize.ValueInt64() > state.DiskSize.ValueInt64() {
+		diags.AddAttributeWarning(
+			path.Root("disk_size"),
+			"Cluster is restarted",
+			fmt.Sprintf("Growing disk_size from %d to %d GB expands the data volumes with a rolling restart. "+
+				"Each replica is unavailable while it restarts.", state.DiskSize.ValueInt64(), plan.DiskSize.ValueInt64()),
+		)
+	}
+	if !plan.Shards.IsUnknown() && !plan.Shards.Equal(state.Shards) {
+		diags.AddAttributeWarning(
+			path.Root("shards"),
+			"Cluster data is rebalanced",
+			fmt.Sprintf("Changing shards from %d to %d does not move existing data. New shards start empty, "+
+				"and the data of removed shards has to be moved to the remaining shards before they are removed.", state.Shards.ValueInt64(), plan.Shards.ValueInt64()),
+		)
+	}
+	if !plan.Replicas.IsUnknown() && plan.Replicas.ValueInt64() > state.Replicas.ValueInt64() {
+		diags.AddAttributeWarning(
+			path.Root("replicas"),
+			"Cluster data is replicated",
+			fmt.Sprintf("Growing replicas from %d to %d copies the data of every shard to the new replicas, "+
+				"which adds load to the existing replicas until they catch up.", state.Replicas.ValueInt64(), plan.Replicas.ValueInt64()),
+		)
+	}
+
+	return diags
+}
+
+// upgradeDiagnostics - warns about the rolling upgrade of an in-place version change and rejects
+// downgrades unless allow_downgrade is set.
+func upgradeDiagnostics(plan, state ClusterResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if plan.Version.IsUnknown() || plan.Version.Equal(state.Version) || clusterReplaced(plan, state) {
+		return diags
+	}
+
+	from, to := state.Version.ValueString(), plan.Version.ValueString()
+	if compareClickHouseVersions(to, from) < 0 && !plan.AllowDowngrade.ValueBool() {
+		// allow_downgrade may only be known during apply
+		if plan.AllowDowngrade.IsUnknown() {
+			return diags
+		}
+		diags.AddAttributeError(
+			path.Root("version"),
+			"ClickHouse downgrade not allowed",
+			fmt.Sprintf("Changing version from %s to %s downgrades ClickHouse, which can leave data in formats the older version cannot read. "+
+				"Set allow_downgrade = true to downgrade anyway.", from, to),
+		)
+		return diags
+	}
+
+	diags.AddAttributeWarning(
+		path.Root("version"),
+		"Cluster is upgraded",
+		fmt.Sprintf("Changing version from %s to %s upgrades ClickHouse with a rolling restart. "+
+			"Each replica is unavailable while it restarts.", from, to),
+	)
+	return diags
+}
+
+// clusterScaleChanged - reports whether the shards, replicas, node type or disk size of the cluster change.
+func clusterScaleChanged(current, desired client.Cluster) bool {
+	return current.Shards != desired.Shards || current.Replicas != desired.Replicas ||
+		current.NodeType != desired.NodeType || current.DiskSize != desired.DiskSize
+}
+
+// diskShrinkRequiresReplace - replaces the cluster when disk_size is reduced, data volumes only grow.
+func diskShrinkRequiresReplace(_ context.Context, req planmodifier.Int64Request, resp *int64planmodifier.RequiresReplaceIfFuncResponse) {
+	if req.StateValue.IsNull() || req.PlanValue.IsUnknown() {
+		return
+	}
+	resp.RequiresReplace = req.PlanValue.ValueInt64() < req.StateValue.ValueInt64()
+}
+
+// mapClusterModelToCluster - converts the Terraform model into an API request.
+func mapClusterModelToCluster(m ClusterResourceModel) client.Cluster {
+	return client.Cluster{
+		ID:            m.ID.ValueString(),
+		EnvID:         m.EnvID.ValueString(),
+		Name:          m.Name.ValueString(),
+		Version:       m.Version.ValueString(),
+		NodeType:      m.NodeType.ValueString(),
+		Shards:        m.Shards.ValueInt64(),
+		Replicas:      m.Replicas.ValueInt64(),
+		DiskSize:      m.DiskSize.ValueInt64(),
+		Zookeeper:     m.Zookeeper.ValueString(),
+		AdminUser:     m.AdminUser.ValueString(),
+		AdminPassword: m.AdminPassword.ValueString(),
+	}
+}
+
+// mapClusterToClusterModel - copies the API response into the Terraform model.
+// The admin password is never returned by the API, so it is kept as is.
+func mapClusterToClusterModel(cluster client.Cluster, m *ClusterResourceModel) {
+	m.ID = types.StringValue(cluster.ID)
+	m.Name = types.StringValue(cluster.Name)
+	m.Version = types.StringValue(cluster.Version)
+	m.NodeType = types.StringValue(cluster.NodeType)
+	m.Shards = types.Int64Value(cluster.Shards)
+	m.Replicas = types.Int64Value(cluster.Replicas)
+	m.DiskSize = types.Int64Value(cluster.DiskSize)
+	m.Zookeeper = types.StringValue(cluster.Zookeeper)
+	m.AdminUser = types.StringValue(cluster.AdminUser)
+	m.Status = types.StringValue(cluster.Status)
+
+	// the environment is only known from the API after an import
+	if len(cluster.EnvID) > 0 {
+		m.EnvID = types.StringValue(cluster.EnvID)
+	}
+}
@@ -0,0 +1,510 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/tatari-tv/terraform-provider-altinitycloud/cmd/client"
+	"github.com/tatari-tv/terraform-provider-altinitycloud/internal/fakeacm"
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestMapClusterToClusterModel(t *testing.T) {
+	c := client.Cluster{
+		ID:        "42",
+		EnvID:     "648",
+		Name:      "analytics",
+		Version:   "24.3.5.47.altinitystable",
+		NodeType:  "m6i.xlarge",
+		Shards:    2,
+		Replicas:  3,
+		DiskSize:  100,
+		Zookeeper: "launch",
+		AdminUser: "admin",
+		Status:    client.ClusterStatusOnline,
+	}
+
+	m := ClusterResourceModel{
+		AdminPassword: types.StringValue("secret"),
+	}
+	mapClusterToClusterModel(c, &m)
+	assert.Equal(t, c.ID, m.ID.ValueString())
+	assert.Equal(t, c.EnvID, m.EnvID.ValueString())
+	assert.Equal(t, c.Name, m.Name.ValueString())
+	assert.Equal(t, c.Version, m.Version.ValueString())
+	assert.Equal(t, c.NodeType, m.NodeType.ValueString())
+	assert.Equal(t, c.Shards, m.Shards.ValueInt64())
+	assert.Equal(t, c.Replicas, m.Replicas.ValueInt64())
+	assert.Equal(t, c.DiskSize, m.DiskSize.ValueInt64())
+	assert.Equal(t, c.Status, m.Status.ValueString())
+	// the admin password is never returned by the API
+	assert.Equal(t, "secret", m.AdminPassword.ValueString())
+
+	// keep the configured environment when the API does not return one
+	m2 := ClusterResourceModel{EnvID: types.StringValue("649")}
+	c.EnvID = ""
+	mapClusterToClusterModel(c, &m2)
+	assert.Equal(t, "649", m2.EnvID.ValueString())
+
+	req := mapClusterModelToCluster(m)
+	assert.Equal(t, "secret", req.AdminPassword)
+	assert.Equal(t, int64(2), req.Shards)
+}
+
+func TestAccClusterResource(t *testing.T) {
+	s := fakeacm.NewServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig(s) + testAccClusterResourceConfig("secret"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("altinitycloud_cluster.test", "id"),
+					resource.TestCheckResourceAttr("altinitycloud_cluster.test", "status", client.ClusterStatusOnline),
+					resource.TestCheckResourceAttr("altinitycloud_cluster.test", "shards", "1"),
+					resource.TestCheckResourceAttr("altinitycloud_cluster.test", "zookeeper", "launch"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "altinitycloud_cluster.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated", "admin_password"},
+			},
+			// Change the admin password in place
+			{
+				Config: testAccProviderConfig(s) + testAccClusterResourceConfig("changed"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("altinitycloud_cluster.test", "admin_password", "changed"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccClusterResourceImportAdminPassword(t *testing.T) {
+	s := fakeacm.NewServer(t)
+	c := s.AddCluster(client.Cluster{
+		EnvID:         "648",
+		Name:          "tf-acc",
+		Version:       "24.3.5.47.altinitystable",
+		NodeType:      "m6i.xlarge",
+		Shards:        1,
+		Replicas:      1,
+		DiskSize:      100,
+		Zookeeper:     "launch",
+		AdminUser:     "admin",
+		AdminPassword: "secret",
+	})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The imported state has no admin password, the API never returns it
+			{
+				Config:             testAccProviderConfig(s) + testAccClusterResourceConfig("configured"),
+				ResourceName:       "altinitycloud_cluster.test",
+				ImportState:        true,
+				ImportStateId:      c.ID,
+				ImportStatePersist: true,
+			},
+			// The configured password is saved to state without resetting the live one
+			{
+				Config: testAccProviderConfig(s) + testAccClusterResourceConfig("configured"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("altinitycloud_cluster.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("altinitycloud_cluster.test", "admin_password", "configured"),
+					testAccCheckClusterAdminPassword(s, c.ID, "secret"),
+				),
+			},
+			// Changing it afterwards resets the live password
+			{
+				Config: testAccProviderConfig(s) + testAccClusterResourceConfig("changed"),
+				Check:  testAccCheckClusterAdminPassword(s, c.ID, "changed"),
+			},
+		},
+	})
+}
+
+// testAccCheckClusterAdminPassword - checks the admin password the fake API stored for the cluster.
+func testAccCheckClusterAdminPassword(s *fakeacm.Server, ID, want string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		c, ok := s.Cluster(ID)
+		if !ok {
+			return fmt.Errorf("cluster %s not found in the API", ID)
+		}
+		if c.AdminPassword != want {
+			return fmt.Errorf("cluster admin password is %q, want %q", c.AdminPassword, want)
+		}
+		return nil
+	}
+}
+
+func TestAccClusterResourceRescale(t *testing.T) {
+	s := fakeacm.NewServer(t)
+	var clusterID string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(s) + testAccClusterResourceScaleConfig("m6i.xlarge", 1, 100),
+				Check:  testAccCaptureID("altinitycloud_cluster.test", &clusterID),
+			},
+			// Rescale the cluster in place
+			{
+				Config: testAccProviderConfig(s) + testAccClusterResourceScaleConfig("m6i.2xlarge", 2, 200),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("altinitycloud_cluster.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPtr("altinitycloud_cluster.test", "id", &clusterID),
+					resource.TestCheckResourceAttr("altinitycloud_cluster.test", "node_type", "m6i.2xlarge"),
+					resource.TestCheckResourceAttr("altinitycloud_cluster.test", "shards", "2"),
+					resource.TestCheckResourceAttr("altinitycloud_cluster.test", "disk_size", "200"),
+					resource.TestCheckResourceAttr("altinitycloud_cluster.test", "status", client.ClusterStatusOnline),
+					testAccCheckClusterRescales(s, 1),
+				),
+			},
+			// Data volumes cannot shrink, so a smaller disk replaces the cluster
+			{
+				Config: testAccProviderConfig(s) + testAccClusterResourceScaleConfig("m6i.2xlarge", 2, 150),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("altinitycloud_cluster.test", plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("altinitycloud_cluster.test", "disk_size", "150"),
+			},
+		},
+	})
+}
+
+func TestAccClusterResourceUpgrade(t *testing.T) {
+	s := fakeacm.NewServer(t)
+	var clusterID string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(s) + testAccClusterResourceVersionConfig("24.3.5.47.altinitystable", false),
+				Check:  testAccCaptureID("altinitycloud_cluster.test", &clusterID),
+			},
+			// Upgrade the cluster in place
+			{
+				Config: testAccProviderConfig(s) + testAccClusterResourceVersionConfig("24.8.14.10459.altinitystable", false),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("altinitycloud_cluster.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPtr("altinitycloud_cluster.test", "id", &clusterID),
+					resource.TestCheckResourceAttr("altinitycloud_cluster.test", "version", "24.8.14.10459.altinitystable"),
+					resource.TestCheckResourceAttr("altinitycloud_cluster.test", "status", client.ClusterStatusOnline),
+				),
+			},
+			// Downgrades are rejected during plan
+			{
+				Config:      testAccProviderConfig(s) + testAccClusterResourceVersionConfig("24.3.12.75.altinitystable", false),
+				ExpectError: regexp.MustCompile(`ClickHouse downgrade not allowed`),
+			},
+			// unless they are allowed explicitly
+			{
+				Config: testAccProviderConfig(s) + testAccClusterResourceVersionConfig("24.3.12.75.altinitystable", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPtr("altinitycloud_cluster.test", "id", &clusterID),
+					resource.TestCheckResourceAttr("altinitycloud_cluster.test", "version", "24.3.12.75.altinitystable"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "altinitycloud_cluster.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated", "admin_password", "allow_downgrade"},
+			},
+		},
+	})
+}
+
+func TestRescaleWarnings(t *testing.T) {
+	state := ClusterResourceModel{
+		EnvID:     types.StringValue("648"),
+		Name:      types.StringValue("analytics"),
+		Version:   types.StringValue("24.3.5.47.altinitystable"),
+		NodeType:  types.StringValue("m6i.xlarge"),
+		Shards:    types.Int64Value(2),
+		Replicas:  types.Int64Value(2),
+		DiskSize:  types.Int64Value(100),
+		Zookeeper: types.StringValue("launch"),
+		AdminUser: types.StringValue("admin"),
+	}
+
+	// nothing to warn about without size changes
+	assert.False(t, rescaleWarnings(state, state).WarningsCount() > 0)
+
+	plan := state
+	plan.NodeType = types.StringValue("m6i.2xlarge")
+	plan.DiskSize = types.Int64Value(200)
+	plan.Shards = types.Int64Value(3)
+	plan.Replicas = types.Int64Value(3)
+	assert.Equal(t, 4, rescaleWarnings(plan, state).WarningsCount())
+
+	// removing replicas neither restarts nor moves data
+	plan = state
+	plan.Replicas = types.Int64Value(1)
+	assert.Equal(t, 0, rescaleWarnings(plan, state).WarningsCount())
+
+	// unknown values are not compared
+	plan = state
+	plan.Shards = types.Int64Unknown()
+	assert.Equal(t, 0, rescaleWarnings(plan, state).WarningsCount())
+
+	// replaced clusters are not rescaled
+	plan = state
+	plan.NodeType = types.StringValue("m6i.2xlarge")
+	plan.DiskSize = types.Int64Value(50)
+	assert.Equal(t, 0, rescaleWarnings(plan, state).WarningsCount())
+}
+
+// testAccCheckClusterRescales - checks how many times the fake API rescaled the test cluster.
+func testAccCheckClusterRescales(s *fakeacm.Server, want int) resource.TestCheckFunc {
+	return func(st *terraform.State) error {
+		rs, ok := st.RootModule().Resources["altinitycloud_cluster.test"]
+		if !ok {
+			return fmt.Errorf("altinitycloud_cluster.test not found in state")
+		}
+		if got := s.Rescales(rs.Primary.ID); got != want {
+			return fmt.Errorf("cluster %s was rescaled %d times, want %d", rs.Primary.ID, got, want)
+		}
+		return nil
+	}
+}
+
+func TestFindRestoreBackup(t *testing.T) {
+	bs := []client.Backup{
+		{ID: "1", Name: "nightly-1", Status: client.BackupStatusCompleted, CreatedAt: "2024-05-01T03:00:00Z"},
+		{ID: "2", Name: "nightly-2", Status: client.BackupStatusCompleted, CreatedAt: "2024-05-02T03:00:00Z"},
+		{ID: "3", Name: "nightly-3", Status: client.BackupStatusFailed, CreatedAt: "2024-05-03T03:00:00Z"},
+	}
+	at := func(s string) *time.Time {
+		t, _ := time.Parse(time.RFC3339, s)
+		return &t
+	}
+
+	b, ok := findRestoreBackup(bs, "nightly-1", nil)
+	assert.True(t, ok)
+	assert.Equal(t, "1", b.ID)
+
+	b, ok = findRestoreBackup(bs, "", at("2024-05-02T12:00:00Z"))
+	assert.True(t, ok)
+	assert.Equal(t, "2", b.ID)
+
+	// failed backups are never restored
+	b, ok = findRestoreBackup(bs, "", at("2024-05-04T00:00:00Z"))
+	assert.True(t, ok)
+	assert.Equal(t, "2", b.ID)
+	_, ok = findRestoreBackup(bs, "nightly-3", nil)
+	assert.False(t, ok)
+
+	_, ok = findRestoreBackup(bs, "", at("2024-04-30T00:00:00Z"))
+	assert.False(t, ok)
+}
+
+func TestAccClusterResourceRestore(t *testing.T) {
+	s := fakeacm.NewServer(t)
+	s.AddBackup(client.Backup{ID: "b1", ClusterID: "src", Name: "nightly-1", Status: client.BackupStatusCompleted, CreatedAt: "2024-05-01T03:00:00Z"})
+	s.AddBackup(client.Backup{ID: "b2", ClusterID: "src", Name: "nightly-2", Status: client.BackupStatusCompleted, CreatedAt: "2024-05-02T03:00:00Z"})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// A missing backup fails before the cluster is launched
+			{
+				Config:      testAccProviderConfig(s) + testAccClusterResourceRestoreConfig(`backup_name = "weekly"`),
+				ExpectError: regexp.MustCompile(`Backup not found`),
+			},
+			// Restore the latest backup taken before the timestamp
+			{
+				Config: testAccProviderConfig(s) + testAccClusterResourceRestoreConfig(`backup_timestamp = "2024-05-02T12:00:00Z"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("altinitycloud_cluster.test", "restore_from.source_cluster_id", "src"),
+					testAccCheckClusterRestored(s, "b2"),
+				),
+			},
+			// Changing the backup later does not replace the cluster
+			{
+				Config:   testAccProviderConfig(s) + testAccClusterResourceRestoreConfig(`backup_name = "nightly-1"`),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccClusterResourceRestoreFailure(t *testing.T) {
+	s := fakeacm.NewServer(t)
+	s.RestoreError = "table events.clicks already exists"
+	s.AddBackup(client.Backup{ID: "b1", ClusterID: "src", Name: "nightly-1", Status: client.BackupStatusCompleted, CreatedAt: "2024-05-01T03:00:00Z"})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProviderConfig(s) + testAccClusterResourceRestoreConfig(`backup_name = "nightly-1"`),
+				ExpectError: regexp.MustCompile(`(?s)Error restoring cluster.*table events.clicks already exists`),
+			},
+			// the cluster was created and is replaced on the next apply
+			{
+				Config:             testAccProviderConfig(s) + testAccClusterResourceRestoreConfig(`backup_name = "nightly-1"`),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccClusterResourceCreateTimeout(t *testing.T) {
+	s := fakeacm.NewServer(t)
+	s.ClusterLaunchPolls = 1 << 30
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProviderConfig(s) + testAccClusterResourceTimeoutConfig("1s"),
+				ExpectError: regexp.MustCompile(`(?s)Error waiting for cluster.*timeout while waiting for cluster .*, last status launching`),
+			},
+			// the launching cluster was saved to state and tainted, so it is replaced instead of orphaned
+			{
+				PreConfig: func() { s.ClusterLaunchPolls = 2 },
+				Config:    testAccProviderConfig(s) + testAccClusterResourceTimeoutConfig("5m"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("altinitycloud_cluster.test", plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("altinitycloud_cluster.test", "status", client.ClusterStatusOnline),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckClusterRestored - checks the fake API restored the backup into the test cluster.
+func testAccCheckClusterRestored(s *fakeacm.Server, backupID string) resource.TestCheckFunc {
+	return func(st *terraform.State) error {
+		rs, ok := st.RootModule().Resources["altinitycloud_cluster.test"]
+		if !ok {
+			return fmt.Errorf("altinitycloud_cluster.test not found in state")
+		}
+		restores := s.Restores(rs.Primary.ID)
+		if len(restores) != 1 {
+			return fmt.Errorf("cluster was restored %d times, want 1", len(restores))
+		}
+		if restores[0].BackupID != backupID || restores[0].Status != client.RestoreStatusCompleted {
+			return fmt.Errorf("cluster restore of backup %s is %s, want %s completed", restores[0].BackupID, restores[0].Status, backupID)
+		}
+		return nil
+	}
+}
+
+func testAccClusterResourceRestoreConfig(backup string) string {
+	return fmt.Sprintf(`
+resource "altinitycloud_cluster" "test" {
+  env_id         = "648"
+  name           = "tf-acc-staging"
+  version        = "24.3.5.47.altinitystable"
+  node_type      = "m6i.xlarge"
+  disk_size      = 100
+  admin_password = "secret"
+
+  restore_from = {
+    source_cluster_id = "src"
+    %s
+  }
+
+  timeouts {
+    create = "5m"
+  }
+}
+`, backup)
+}
+
+func testAccClusterResourceConfig(password string) string {
+	return fmt.Sprintf(`
+resource "altinitycloud_cluster" "test" {
+  env_id         = "648"
+  name           = "tf-acc"
+  version        = "24.3.5.47.altinitystable"
+  node_type      = "m6i.xlarge"
+  disk_size      = 100
+  admin_password = %q
+}
+`, password)
+}
+
+func testAccClusterResourceTimeoutConfig(create string) string {
+	return fmt.Sprintf(`
+resource "altinitycloud_cluster" "test" {
+  env_id         = "648"
+  name           = "tf-acc"
+  version        = "24.3.5.47.altinitystable"
+  node_type      = "m6i.xlarge"
+  disk_size      = 100
+  admin_password = "secret"
+
+  timeouts {
+    create = %q
+  }
+}
+`, create)
+}
+
+func testAccClusterResourceScaleConfig(nodeType string, shards, diskSize int) string {
+	return fmt.Sprintf(`
+resource "altinitycloud_cluster" "test" {
+  env_id         = "648"
+  name           = "tf-acc"
+  version        = "24.3.5.47.altinitystable"
+  node_type      = %q
+  shards         = %d
+  disk_size      = %d
+  admin_password = "secret"
+
+  timeouts {
+    update = "2h"
+  }
+}
+`, nodeType, shards, diskSize)
+}
+
+func testAccClusterResourceVersionConfig(version string, allowDowngrade bool) string {
+	return fmt.Sprintf(`
+resource "altinitycloud_cluster" "test" {
+  env_id          = "648"
+  name            = "tf-acc"
+  version         = %q
+  allow_downgrade = %t
+  node_type       = "m6i.xlarge"
+  disk_size       = 100
+  admin_password  = "secret"
+}
+`, version, allowDowngrade)
+}
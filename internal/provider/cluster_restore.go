@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/tatari-tv/terraform-provider-altinitycloud/cmd/client"
+	"time"
+)
+
+// restoreFromPlanModifier - restore_from only applies when the cluster is created. Later
+// changes keep the state value and warn, so they never replace a running cluster.
+type restoreFromPlanModifier struct{}
+
+var _ planmodifier.Object = restoreFromPlanModifier{}
+
+// Description - returns a plain text description of the plan modifier.
+func (m restoreFromPlanModifier) Description(_ context.Context) string {
+	return "restore_from is only used when the cluster is created, later changes are ignored"
+}
+
+// MarkdownDescription - returns a markdown description of the plan modifier.
+func (m restoreFromPlanModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+// PlanModifyObject - plans no restore for new clusters without restore_from and keeps the state of existing ones.
+func (m restoreFromPlanModifier) PlanModifyObject(ctx context.Context, req planmodifier.ObjectRequest, resp *planmodifier.ObjectResponse) {
+	// the resource is destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	if req.State.Raw.IsNull() {
+		if req.ConfigValue.IsNull() {
+			resp.PlanValue = types.ObjectNull(req.PlanValue.AttributeTypes(ctx))
+		}
+		return
+	}
+
+	if !req.ConfigValue.IsUnknown() && !req.ConfigValue.Equal(req.StateValue) {
+		resp.Diagnostics.AddAttributeWarning(
+			req.Path,
+			"Cluster restore is not changed",
+			"restore_from is only used when the cluster is created, the change is ignored. "+
+				"Replace the cluster, e.g. with `terraform apply -replace`, to restore it from another backup.",
+		)
+	}
+	resp.PlanValue = req.StateValue
+}
+
+// findRestoreBackup - returns the completed backup with the given name, or the latest completed
+// backup taken at or before the given time.
+func findRestoreBackup(bs []client.Backup, name string, before *time.Time) (client.Backup, bool) {
+	var found client.Backup
+	var foundAt time.Time
+	for _, b := range bs {
+		if b.Status != client.BackupStatusCompleted {
+			continue
+		}
+		if before == nil {
+			if b.Name == name {
+				return b, true
+			}
+			continue
+		}
+
+		createdAt, err := time.Parse(time.RFC3339, b.CreatedAt)
+		if err != nil || createdAt.After(*before) {
+			continue
+		}
+		if found.ID == "" || createdAt.After(foundAt) {
+			found, foundAt = b, createdAt
+		}
+	}
+	return found, found.ID != ""
+}
+
+// resolveRestoreBackup - looks up the backup of the source cluster the new cluster is restored from.
+func (r *clusterResource) resolveRestoreBackup(ctx context.Context, restoreFrom types.Object) (client.Backup, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var m RestoreFromModel
+	diags.Append(restoreFrom.As(ctx, &m, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return client.Backup{}, diags
+	}
+
+	var before *time.Time
+	attrPath := path.Root("restore_from").AtName("backup_name")
+	if !m.BackupTimestamp.IsNull() {
+		t, err := time.Parse(time.RFC3339, m.BackupTimestamp.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("restore_from").AtName("backup_timestamp"), "Invalid Timestamp", err.Error())
+			return client.Backup{}, diags
+		}
+		before = &t
+		attrPath = path.Root("restore_from").AtName("backup_timestamp")
+	}
+
+	bd, err := r.client.GetBackups(ctx, m.SourceClusterID.ValueString())
+	if err != nil {
+		addClientError(&diags, "Error creating cluster", "Could not list backups of source cluster "+m.SourceClusterID.ValueString(), err, nil)
+		return client.Backup{}, diags
+	}
+
+	backup, ok := findRestoreBackup(bd.Backups, m.BackupName.ValueString(), before)
+	if !ok {
+		diags.AddAttributeError(
+			attrPath,
+			"Backup not found",
+			fmt.Sprintf("Source cluster %s has no completed backup matching %s.", m.SourceClusterID.ValueString(), describeRestoreFrom(m)),
+		)
+		return client.Backup{}, diags
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("restoring backup %s (%s) of cluster %s", backup.Name, backup.ID, m.SourceClusterID.ValueString()))
+	return backup, diags
+}
+
+// restoreCluster - restores the backup into the cluster and waits for the restore to finish.
+func (r *clusterResource) restoreCluster(ctx context.Context, clusterID string, backup client.Backup) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	restore, err := r.client.RestoreCluster(ctx, clusterID, backup.ID)
+	if err != nil {
+		addClientError(&diags, "Error restoring cluster", "Could not restore backup "+backup.Name, err, restoreFieldPaths)
+		return diags
+	}
+
+	_, err = r.client.WaitForRestore(ctx, restore.ID, func(progress client.Restore) {
+		tflog.Info(ctx, fmt.Sprintf("restore %s is %s, %d%% done", restore.ID, progress.Status, progress.Progress))
+	})
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("restore_from"),
+			"Error restoring cluster",
+			fmt.Sprintf("Restore %s of backup %s into cluster %s did not complete: %s. "+
+				"The cluster was created and is replaced on the next apply.", restore.ID, backup.Name, clusterID, err),
+		)
+	}
+
+	return diags
+}
+
+// describeRestoreFrom - returns a readable description of the requested backup.
+func describeRestoreFrom(m RestoreFromModel) string {
+	if !m.BackupTimestamp.IsNull() {
+		return "taken at or before " + m.BackupTimestamp.ValueString()
+	}
+	return "name " + m.BackupName.ValueString()
+}
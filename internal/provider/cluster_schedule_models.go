@@ -0,0 +1,20 @@
+package provider
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// ClusterScheduleResourceModel - describes the cluster uptime schedule model for resources.
+type ClusterScheduleResourceModel struct {
+	ID            types.String                `tfsdk:"id"`
+	ClusterID     types.String                `tfsdk:"cluster_id"`
+	Mode          types.String                `tfsdk:"mode"`
+	InactiveHours types.Int64                 `tfsdk:"inactive_hours"`
+	Timezone      types.String                `tfsdk:"timezone"`
+	Days          map[string]ScheduleDayModel `tfsdk:"days"`
+	LastUpdated   types.String                `tfsdk:"last_updated"`
+}
+
+// ScheduleDayModel - describes the start and stop time of a weekly schedule day.
+type ScheduleDayModel struct {
+	Start types.String `tfsdk:"start"`
+	Stop  types.String `tfsdk:"stop"`
+}
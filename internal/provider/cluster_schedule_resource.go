@@ -0,0 +1,350 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/tatari-tv/terraform-provider-altinitycloud/cmd/client"
+	"time"
+)
+
+// defaultScheduleTimezone - timezone of weekly schedules that do not set one.
+const defaultScheduleTimezone = "UTC"
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &clusterScheduleResource{}
+	_ resource.ResourceWithConfigure      = &clusterScheduleResource{}
+	_ resource.ResourceWithImportState    = &clusterScheduleResource{}
+	_ resource.ResourceWithValidateConfig = &clusterScheduleResource{}
+)
+
+// NewClusterScheduleResource is a helper function to simplify the provider implementation.
+func NewClusterScheduleResource() resource.Resource {
+	return &clusterScheduleResource{}
+}
+
+// clusterScheduleResource is the resource implementation.
+type clusterScheduleResource struct {
+	client *client.AltinityCloudClient
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *clusterScheduleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.AltinityCloudClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Cluster Schedule Source Configure Type",
+			fmt.Sprintf("Expected *client.AltinityCloudClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Metadata - returns the resource type name.
+func (r *clusterScheduleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_schedule"
+}
+
+// Schema - defines the schema for the resource.
+func (r *clusterScheduleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the uptime schedule of an Altinity.Cloud cluster. A cluster is always on, stopped after a number " +
+			"of inactive hours, or runs on a weekly schedule. Destroying the resource removes the schedule and keeps the cluster always on.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Cluster schedule ID, same as `cluster_id`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cluster_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Altinity.Cloud cluster ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"mode": schema.StringAttribute{
+				Required: true,
+				MarkdownDescription: "Schedule mode, one of `always_on`, `stop_when_inactive` (requires `inactive_hours`) " +
+					"or `weekly` (requires `days`).",
+				Validators: []validator.String{
+					stringvalidator.OneOf(scheduleModes...),
+				},
+			},
+			"inactive_hours": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Stop the cluster after it received no queries for this many hours. Only used with `stop_when_inactive`.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"timezone": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "IANA timezone of the `days` start and stop times, e.g. `America/New_York`. Defaults to `UTC`.",
+				Default:             stringdefault.StaticString(defaultScheduleTimezone),
+				Validators: []validator.String{
+					timezone(),
+				},
+			},
+			"days": schema.MapNestedAttribute{
+				Optional: true,
+				MarkdownDescription: "Days of the week the cluster runs, keyed by lowercase weekday name, e.g. `monday`. " +
+					"The cluster is stopped on days that are not listed. Only used with `weekly`.",
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+					mapvalidator.KeysAre(stringvalidator.OneOf(scheduleWeekdays...)),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"start": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "Time of day the cluster is started, e.g. `08:00`.",
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(timeOfDayRegexp, "must be a 24-hour time of day, e.g. `08:00`"),
+							},
+						},
+						"stop": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "Time of day the cluster is stopped, e.g. `20:00`.",
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(timeOfDayRegexp, "must be a 24-hour time of day, e.g. `20:00`"),
+							},
+						},
+					},
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Cluster schedule last updated timestamp. This is auto-generated by the provider.",
+			},
+		},
+	}
+}
+
+// ValidateConfig - checks that only the attributes used by the schedule mode are set.
+func (r *clusterScheduleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var mode types.String
+	var inactiveHours types.Int64
+	var days types.Map
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("mode"), &mode)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("inactive_hours"), &inactiveHours)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("days"), &days)...)
+	if resp.Diagnostics.HasError() || mode.IsUnknown() || mode.IsNull() {
+		return
+	}
+
+	m := mode.ValueString()
+	switch {
+	case m == client.ScheduleModeStopWhenInactive && inactiveHours.IsNull():
+		resp.Diagnostics.AddAttributeError(path.Root("inactive_hours"), "Missing Attribute",
+			"inactive_hours is required when mode is stop_when_inactive.")
+	case m != client.ScheduleModeStopWhenInactive && !inactiveHours.IsNull():
+		resp.Diagnostics.AddAttributeError(path.Root("inactive_hours"), "Invalid Attribute Combination",
+			fmt.Sprintf("inactive_hours is only used when mode is stop_when_inactive, got mode %s.", m))
+	}
+	switch {
+	case m == client.ScheduleModeWeekly && days.IsNull():
+		resp.Diagnostics.AddAttributeError(path.Root("days"), "Missing Attribute",
+			"days is required when mode is weekly.")
+	case m != client.ScheduleModeWeekly && !days.IsNull():
+		resp.Diagnostics.AddAttributeError(path.Root("days"), "Invalid Attribute Combination",
+			fmt.Sprintf("days is only used when mode is weekly, got mode %s.", m))
+	}
+}
+
+// Create - sets the uptime schedule of the cluster and sets the initial Terraform state.
+func (r *clusterScheduleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating cluster schedule resource")
+	// Retrieve values from plan
+	var plan ClusterScheduleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Failed to retrieve plan for Altinity.Cloud cluster schedule resource")
+		return
+	}
+
+	// Set cluster schedule
+	tflog.Info(ctx, fmt.Sprintf("Setting %s schedule of cluster %s", plan.Mode.ValueString(), plan.ClusterID.ValueString()))
+	schedule, err := r.client.SetClusterSchedule(ctx, mapClusterScheduleModelToClusterSchedule(plan))
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error creating cluster schedule", "Could not set cluster schedule", err, clusterScheduleFieldPaths)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	mapClusterScheduleToClusterScheduleModel(schedule, &plan)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	// Set plan to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *clusterScheduleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Read Altinity.Cloud cluster schedule resource")
+	// Get current state
+	var state ClusterScheduleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed cluster schedule from Altinity.Cloud, it may have been removed outside Terraform
+	schedule, err := r.client.GetClusterSchedule(ctx, state.ClusterID.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, fmt.Sprintf("schedule of cluster %s not found, removing it from state", state.ClusterID.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error retrieving cluster schedule", "Could not retrieve cluster schedule", err, nil)
+		return
+	}
+
+	// Overwrite current state with refreshed data
+	mapClusterScheduleToClusterScheduleModel(schedule, &state)
+
+	tflog.Trace(ctx, fmt.Sprintf("refreshed schedule of cluster %s from API", state.ClusterID.ValueString()))
+
+	// set refreshed state
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update - replaces the cluster schedule and sets the updated Terraform state on success.
+func (r *clusterScheduleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Update cluster schedule resource")
+	// Retrieve values from plan
+	var plan ClusterScheduleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Failed to retrieve plan for Altinity.Cloud cluster schedule resource")
+		return
+	}
+
+	// Update cluster schedule in Altinity.Cloud
+	tflog.Info(ctx, fmt.Sprintf("Updating schedule of cluster %s to %s", plan.ClusterID.ValueString(), plan.Mode.ValueString()))
+	schedule, err := r.client.SetClusterSchedule(ctx, mapClusterScheduleModelToClusterSchedule(plan))
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error updating cluster schedule", "Could not update cluster schedule", err, clusterScheduleFieldPaths)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	mapClusterScheduleToClusterScheduleModel(schedule, &plan)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	// Set plan to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete - removes the cluster schedule, which keeps the cluster always on, and removes the Terraform state on success.
+func (r *clusterScheduleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Delete cluster schedule resource")
+	// Retrieve values from state
+	var state ClusterScheduleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Remove cluster schedule, it may already be gone
+	err := r.client.DeleteClusterSchedule(ctx, state.ClusterID.ValueString())
+	if client.IsNotFound(err) {
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error deleting cluster schedule", "Could not delete cluster schedule", err, nil)
+		return
+	}
+}
+
+// ImportState - imports the uptime schedule of a cluster by the cluster ID.
+func (r *clusterScheduleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, "Import cluster schedule resource")
+	// Retrieve import ID and save to id and cluster_id attributes
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_id"), req.ID)...)
+}
+
+// mapClusterScheduleModelToClusterSchedule - converts the Terraform model into an API request.
+func mapClusterScheduleModelToClusterSchedule(m ClusterScheduleResourceModel) client.ClusterSchedule {
+	schedule := client.ClusterSchedule{
+		ClusterID:     m.ClusterID.ValueString(),
+		Mode:          m.Mode.ValueString(),
+		InactiveHours: m.InactiveHours.ValueInt64(),
+		Timezone:      m.Timezone.ValueString(),
+	}
+	if len(m.Days) > 0 {
+		schedule.Days = map[string]client.ScheduleDay{}
+		for day, d := range m.Days {
+			schedule.Days[day] = client.ScheduleDay{Start: d.Start.ValueString(), Stop: d.Stop.ValueString()}
+		}
+	}
+	return schedule
+}
+
+// mapClusterScheduleToClusterScheduleModel - copies the API response into the Terraform model.
+func mapClusterScheduleToClusterScheduleModel(schedule client.ClusterSchedule, m *ClusterScheduleResourceModel) {
+	m.ID = m.ClusterID
+	m.Mode = types.StringValue(schedule.Mode)
+
+	m.InactiveHours = types.Int64Null()
+	if schedule.InactiveHours > 0 {
+		m.InactiveHours = types.Int64Value(schedule.InactiveHours)
+	}
+
+	// the API only returns the timezone of weekly schedules, other modes keep the configured one
+	switch {
+	case len(schedule.Timezone) > 0:
+		m.Timezone = types.StringValue(schedule.Timezone)
+	case m.Timezone.IsNull() || m.Timezone.IsUnknown():
+		m.Timezone = types.StringValue(defaultScheduleTimezone)
+	}
+
+	m.Days = nil
+	if len(schedule.Days) > 0 {
+		m.Days = map[string]ScheduleDayModel{}
+		for day, d := range schedule.Days {
+			m.Days[day] = ScheduleDayModel{Start: types.StringValue(d.Start), Stop: types.StringValue(d.Stop)}
+		}
+	}
+}
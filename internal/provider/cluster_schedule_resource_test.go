@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/tatari-tv/terraform-provider-altinitycloud/cmd/client"
+	"github.com/tatari-tv/terraform-provider-altinitycloud/internal/fakeacm"
+	"regexp"
+	"testing"
+)
+
+func TestAccClusterScheduleResource(t *testing.T) {
+	s := fakeacm.NewServer(t)
+	var clusterID string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Attributes of other modes are rejected during plan
+			{
+				Config: testAccProviderConfig(s) + testAccClusterScheduleResourceConfig(`mode = "always_on"
+  inactive_hours = 4`),
+				ExpectError: regexp.MustCompile(`inactive_hours is only used when mode is stop_when_inactive`),
+			},
+			{
+				Config:      testAccProviderConfig(s) + testAccClusterScheduleResourceConfig(`mode = "weekly"`),
+				ExpectError: regexp.MustCompile(`days is required when mode is weekly`),
+			},
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig(s) + testAccClusterScheduleResourceConfig(`mode = "stop_when_inactive"
+  inactive_hours = 4`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("altinitycloud_cluster_schedule.test", "id", "altinitycloud_cluster.test", "id"),
+					resource.TestCheckResourceAttr("altinitycloud_cluster_schedule.test", "inactive_hours", "4"),
+					resource.TestCheckResourceAttr("altinitycloud_cluster_schedule.test", "timezone", "UTC"),
+					testAccCheckClusterSchedule(s, client.ScheduleModeStopWhenInactive, 0),
+					testAccCaptureID("altinitycloud_cluster.test", &clusterID),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "altinitycloud_cluster_schedule.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			// Update to a weekly schedule
+			{
+				Config: testAccProviderConfig(s) + testAccClusterScheduleResourceConfig(`mode     = "weekly"
+  timezone = "America/New_York"
+  days = {
+    monday = { start = "08:00", stop = "20:00" }
+    friday = { start = "08:00", stop = "18:30" }
+  }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("altinitycloud_cluster_schedule.test", "inactive_hours"),
+					resource.TestCheckResourceAttr("altinitycloud_cluster_schedule.test", "days.%", "2"),
+					resource.TestCheckResourceAttr("altinitycloud_cluster_schedule.test", "days.friday.stop", "18:30"),
+					testAccCheckClusterSchedule(s, client.ScheduleModeWeekly, 2),
+				),
+			},
+			// ImportState testing of weekly schedules
+			{
+				ResourceName:            "altinitycloud_cluster_schedule.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			// Schedules changed outside Terraform are planned back
+			{
+				PreConfig: func() {
+					s.SetClusterSchedule(client.ClusterSchedule{ClusterID: clusterID, Mode: client.ScheduleModeAlwaysOn})
+				},
+				Config: testAccProviderConfig(s) + testAccClusterScheduleResourceConfig(`mode     = "weekly"
+  timezone = "America/New_York"
+  days = {
+    monday = { start = "08:00", stop = "20:00" }
+    friday = { start = "08:00", stop = "18:30" }
+  }`),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+// testAccCheckClusterSchedule - checks the uptime schedule the fake API stored for the test cluster.
+func testAccCheckClusterSchedule(s *fakeacm.Server, mode string, days int) resource.TestCheckFunc {
+	return func(st *terraform.State) error {
+		rs, ok := st.RootModule().Resources["altinitycloud_cluster.test"]
+		if !ok {
+			return fmt.Errorf("altinitycloud_cluster.test not found in state")
+		}
+		cs, ok := s.ClusterSchedule(rs.Primary.ID)
+		if !ok {
+			return fmt.Errorf("schedule of cluster %s not found in the API", rs.Primary.ID)
+		}
+		if cs.Mode != mode || len(cs.Days) != days {
+			return fmt.Errorf("cluster schedule is %s with %d days, want %s with %d", cs.Mode, len(cs.Days), mode, days)
+		}
+		return nil
+	}
+}
+
+func testAccClusterScheduleResourceConfig(schedule string) string {
+	return fmt.Sprintf(`
+resource "altinitycloud_cluster" "test" {
+  env_id         = "648"
+  name           = "tf-acc"
+  version        = "24.3.5.47.altinitystable"
+  node_type      = "m6i.xlarge"
+  disk_size      = 100
+  admin_password = "admin-secret"
+}
+
+resource "altinitycloud_cluster_schedule" "test" {
+  cluster_id = altinitycloud_cluster.test.id
+  %s
+}
+`, schedule)
+}
@@ -0,0 +1,19 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ClusterSettingResourceModel - describes the ClickHouse server setting model for resources.
+type ClusterSettingResourceModel struct {
+	ID              types.String   `tfsdk:"id"`
+	ClusterID       types.String   `tfsdk:"cluster_id"`
+	Name            types.String   `tfsdk:"name"`
+	Value           types.String   `tfsdk:"value"`
+	FileContent     types.String   `tfsdk:"file_content"`
+	RestartRequired types.Bool     `tfsdk:"restart_required"`
+	RestartOnChange types.Bool     `tfsdk:"restart_on_change"`
+	LastUpdated     types.String   `tfsdk:"last_updated"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
+}
@@ -0,0 +1,378 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/tatari-tv/terraform-provider-altinitycloud/cmd/client"
+	"time"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                     = &clusterSettingResource{}
+	_ resource.ResourceWithConfigure        = &clusterSettingResource{}
+	_ resource.ResourceWithImportState      = &clusterSettingResource{}
+	_ resource.ResourceWithConfigValidators = &clusterSettingResource{}
+)
+
+// NewClusterSettingResource is a helper function to simplify the provider implementation.
+func NewClusterSettingResource() resource.Resource {
+	return &clusterSettingResource{}
+}
+
+// clusterSettingResource is the resource implementation.
+type clusterSettingResource struct {
+	client *client.AltinityCloudClient
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *clusterSettingResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.AltinityCloudClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Cluster Setting Source Configure Type",
+			fmt.Sprintf("Expected *client.AltinityCloudClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Metadata - returns the resource type name.
+func (r *clusterSettingResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_setting"
+}
+
+// Schema - defines the schema for the resource.
+func (r *clusterSettingResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a ClickHouse server setting of an Altinity.Cloud cluster, either a config attribute value or a config file content.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Altinity.Cloud cluster setting ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cluster_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Altinity.Cloud cluster ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Setting name, a config attribute path like `logger/level` or a file name like `config.d/logger.xml`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"value": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Config attribute value. Exactly one of `value` or `file_content` must be set.",
+			},
+			"file_content": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Config file content, must not be empty. Exactly one of `value` or `file_content` must be set.",
+				// the API reports an empty file as an empty value
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"restart_required": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether ClickHouse has to be restarted for a change of the setting to apply. Defaults to `false`.",
+				Default:             booldefault.StaticBool(false),
+			},
+			"restart_on_change": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Run a rolling restart of the cluster and wait for it to come back online after the setting is created, changed or removed. A failed restart is reported as a warning and has to be repeated manually. Defaults to `false`.",
+				Default:             booldefault.StaticBool(false),
+			},
+			"last_updated": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Altinity.Cloud cluster setting last updated timestamp. This is auto-generated by the provider.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// ConfigValidators - requires exactly one of value or file_content.
+func (r *clusterSettingResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("value"),
+			path.MatchRoot("file_content"),
+		),
+	}
+}
+
+// Create - creates the cluster setting and sets the initial Terraform state.
+func (r *clusterSettingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating cluster setting resource")
+	// Retrieve values from plan
+	var plan ClusterSettingResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Failed to retrieve plan for Altinity.Cloud cluster setting resource")
+		return
+	}
+
+	// Create new cluster setting
+	tflog.Info(ctx, fmt.Sprintf("Creating setting %s in cluster %s", plan.Name.ValueString(), plan.ClusterID.ValueString()))
+	setting, err := r.client.CreateClusterSetting(ctx, plan.ClusterID.ValueString(), mapClusterSettingModelToClusterSetting(plan))
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error creating cluster setting", "Could not create cluster setting", err, clusterSettingFieldPaths)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	mapClusterSettingToClusterSettingModel(setting, &plan)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	// Set plan to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the setting is stored, a failed restart only warns
+	if plan.RestartOnChange.ValueBool() {
+		timeout, diags := plan.Timeouts.Create(ctx, clusterLaunchTimeout)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(r.restartCluster(ctx, plan.ClusterID.ValueString(), timeout)...)
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *clusterSettingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Read Altinity.Cloud cluster setting resource")
+	// Get current state
+	var state ClusterSettingResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed cluster setting from Altinity.Cloud
+	setting, err := r.client.GetClusterSetting(ctx, state.ID.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, fmt.Sprintf("cluster setting %s not found, removing it from state", state.ID.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error retrieving cluster setting", "Could not retrieve cluster setting", err, nil)
+		return
+	}
+
+	// Overwrite current state with refreshed data, changes made outside Terraform show up as drift
+	mapClusterSettingToClusterSettingModel(setting, &state)
+
+	tflog.Trace(ctx, fmt.Sprintf("refreshed cluster setting %s from API", state.ID.ValueString()))
+
+	// set refreshed state
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update - updates the cluster setting and sets the updated Terraform state on success.
+func (r *clusterSettingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Update cluster setting resource")
+	// Retrieve values from plan and state
+	var plan, state ClusterSettingResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Failed to retrieve plan for Altinity.Cloud cluster setting resource")
+		return
+	}
+
+	// Update cluster setting in Altinity.Cloud
+	tflog.Info(ctx, fmt.Sprintf("Updating cluster setting %s", plan.ID.ValueString()))
+	setting, err := r.client.UpdateClusterSetting(ctx, mapClusterSettingModelToClusterSetting(plan))
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error updating cluster setting", "Could not update cluster setting", err, clusterSettingFieldPaths)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	mapClusterSettingToClusterSettingModel(setting, &plan)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	// Set plan to fully populated data
+	diags := resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// toggling restart_on_change alone does not change the cluster configuration
+	changed := !plan.Value.Equal(state.Value) || !plan.FileContent.Equal(state.FileContent)
+	if plan.RestartOnChange.ValueBool() && changed {
+		timeout, diags := plan.Timeouts.Update(ctx, clusterLaunchTimeout)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(r.restartCluster(ctx, plan.ClusterID.ValueString(), timeout)...)
+	}
+}
+
+// Delete - deletes the cluster setting and removes the Terraform state on success.
+func (r *clusterSettingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Delete cluster setting resource")
+	// Retrieve values from state
+	var state ClusterSettingResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing cluster setting, it may already be gone
+	err := r.client.DeleteClusterSetting(ctx, state.ID.ValueString())
+	if client.IsNotFound(err) {
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error deleting cluster setting", "Could not delete cluster setting", err, nil)
+		return
+	}
+
+	if state.RestartOnChange.ValueBool() {
+		timeout, diags := state.Timeouts.Delete(ctx, clusterLaunchTimeout)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(r.restartCluster(ctx, state.ClusterID.ValueString(), timeout)...)
+	}
+}
+
+// ImportState - imports a cluster setting by "<cluster_id>/<setting_name>".
+func (r *clusterSettingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, "Import cluster setting resource")
+	parts, err := splitImportID(req.ID, 2, "<cluster_id>/<setting_name>")
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", err.Error())
+		return
+	}
+	clusterID, name := parts[0], parts[1]
+
+	// Look up the setting by name, the API only addresses settings by ID
+	sd, err := r.client.GetClusterSettings(ctx, clusterID)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error importing cluster setting", "Could not retrieve cluster settings", err, nil)
+		return
+	}
+
+	for _, s := range sd.Settings {
+		if s.Name == name {
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), s.ID)...)
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_id"), clusterID)...)
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("restart_on_change"), false)...)
+			return
+		}
+	}
+
+	resp.Diagnostics.AddError(
+		"Error importing cluster setting",
+		fmt.Sprintf("Setting %s does not exist in cluster %s.", name, clusterID),
+	)
+}
+
+// restartCluster - runs a rolling restart of the cluster and waits up to the timeout for it to come back online.
+// The setting is applied either way, so a failed restart is a warning asking for a manual restart rather than
+// an error that would taint the setting or be forgotten by the next plan.
+func (r *clusterSettingResource) restartCluster(ctx context.Context, clusterID string, timeout time.Duration) diag.Diagnostics {
+	var errs diag.Diagnostics
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	tflog.Info(ctx, fmt.Sprintf("Restarting cluster %s", clusterID))
+	if _, err := r.client.RestartCluster(ctx, clusterID); err != nil {
+		addClientError(&errs, "Error restarting cluster", "Could not restart cluster "+clusterID, err, nil)
+	} else if _, err := r.client.WaitForCluster(ctx, clusterID, client.ClusterStatusOnline); err != nil {
+		addWaitError(&errs, "Error restarting cluster", "Cluster "+clusterID+" did not come back online", err)
+	}
+
+	var diags diag.Diagnostics
+	for _, d := range errs {
+		diags.AddWarning(d.Summary(), d.Detail()+". The setting is applied, restart the cluster manually for it to take effect.")
+	}
+	return diags
+}
+
+// mapClusterSettingModelToClusterSetting - converts the Terraform model into an API request.
+func mapClusterSettingModelToClusterSetting(m ClusterSettingResourceModel) client.ClusterSetting {
+	return client.ClusterSetting{
+		ID:              m.ID.ValueString(),
+		ClusterID:       m.ClusterID.ValueString(),
+		Name:            m.Name.ValueString(),
+		Value:           m.Value.ValueString(),
+		FileContent:     m.FileContent.ValueString(),
+		RestartRequired: m.RestartRequired.ValueBool(),
+	}
+}
+
+// mapClusterSettingToClusterSettingModel - copies the API response into the Terraform model.
+// A setting holds either a value or a file content, the other one is null.
+func mapClusterSettingToClusterSettingModel(setting client.ClusterSetting, m *ClusterSettingResourceModel) {
+	m.ID = types.StringValue(setting.ID)
+	m.Name = types.StringValue(setting.Name)
+	m.RestartRequired = types.BoolValue(setting.RestartRequired)
+
+	if len(setting.FileContent) > 0 {
+		m.FileContent = types.StringValue(setting.FileContent)
+		m.Value = types.StringNull()
+	} else {
+		m.Value = types.StringValue(setting.Value)
+		m.FileContent = types.StringNull()
+	}
+
+	// the cluster is only known from the API after an import
+	if len(setting.ClusterID) > 0 {
+		m.ClusterID = types.StringValue(setting.ClusterID)
+	}
+}
@@ -0,0 +1,171 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/tatari-tv/terraform-provider-altinitycloud/cmd/client"
+	"github.com/tatari-tv/terraform-provider-altinitycloud/internal/fakeacm"
+	"net/http"
+	"regexp"
+	"testing"
+)
+
+func TestMapClusterSettingToClusterSettingModel(t *testing.T) {
+	m := ClusterSettingResourceModel{ClusterID: types.StringValue("42"), Value: types.StringValue("debug")}
+	mapClusterSettingToClusterSettingModel(client.ClusterSetting{
+		ID:              "3",
+		Name:            "config.d/logger.xml",
+		FileContent:     "<clickhouse/>",
+		RestartRequired: true,
+	}, &m)
+	assert.Equal(t, "42", m.ClusterID.ValueString())
+	assert.Equal(t, "<clickhouse/>", m.FileContent.ValueString())
+	// a value changed into a file outside Terraform shows up as drift
+	assert.True(t, m.Value.IsNull())
+	assert.True(t, m.RestartRequired.ValueBool())
+
+	req := mapClusterSettingModelToClusterSetting(m)
+	assert.Equal(t, "config.d/logger.xml", req.Name)
+	assert.Empty(t, req.Value)
+}
+
+func TestAccClusterSettingResource(t *testing.T) {
+	s := fakeacm.NewServer(t)
+	var settingID string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProviderConfig(s) + testAccClusterSettingResourceConfig(`file_content = ""`),
+				ExpectError: regexp.MustCompile(`file_content\s+string\s+length\s+must\s+be\s+at\s+least\s+1`),
+			},
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig(s) + testAccClusterSettingResourceConfig(`value = "debug"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("altinitycloud_cluster_setting.test", "id"),
+					resource.TestCheckResourceAttr("altinitycloud_cluster_setting.test", "value", "debug"),
+					resource.TestCheckNoResourceAttr("altinitycloud_cluster_setting.test", "file_content"),
+					resource.TestCheckResourceAttr("altinitycloud_cluster_setting.test", "restart_required", "false"),
+					testAccCheckClusterRestarts(s, 0),
+					testAccCaptureID("altinitycloud_cluster_setting.test", &settingID),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "altinitycloud_cluster_setting.test",
+				ImportState:             true,
+				ImportStateIdFunc:       testAccClusterSettingImportID,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			// Changes made outside Terraform are detected by Read and reverted
+			{
+				PreConfig:          func() { s.SetClusterSetting(settingID, "warning") },
+				Config:             testAccProviderConfig(s) + testAccClusterSettingResourceConfig(`value = "debug"`),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccProviderConfig(s) + testAccClusterSettingResourceConfig(`value = "debug"`),
+				Check:  testAccCheckClusterSettingValue(s, &settingID, "debug"),
+			},
+			// Switch to a config file that requires a restart
+			{
+				Config: testAccProviderConfig(s) + testAccClusterSettingResourceConfig(`file_content      = "<clickhouse><logger><level>trace</level></logger></clickhouse>"
+  restart_required  = true
+  restart_on_change = true`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("altinitycloud_cluster_setting.test", "value"),
+					resource.TestCheckResourceAttr("altinitycloud_cluster_setting.test", "restart_required", "true"),
+					testAccCheckClusterRestarts(s, 1),
+				),
+			},
+			// A failed restart warns without failing the applied setting
+			{
+				PreConfig: func() {
+					s.Inject(fakeacm.Fault{Method: http.MethodPost, Path: "/cluster/", Status: http.StatusBadRequest, Times: 1})
+				},
+				Config: testAccProviderConfig(s) + testAccClusterSettingResourceConfig(`file_content      = "<clickhouse><logger><level>debug</level></logger></clickhouse>"
+  restart_required  = true
+  restart_on_change = true`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("altinitycloud_cluster_setting.test", "file_content", "<clickhouse><logger><level>debug</level></logger></clickhouse>"),
+					testAccCheckClusterRestarts(s, 1),
+				),
+			},
+		},
+	})
+}
+
+// testAccCaptureID - stores the ID of a resource for later test steps.
+func testAccCaptureID(name string, ID *string) resource.TestCheckFunc {
+	return func(st *terraform.State) error {
+		rs, ok := st.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("%s not found in state", name)
+		}
+		*ID = rs.Primary.ID
+		return nil
+	}
+}
+
+// testAccCheckClusterSettingValue - checks the value the fake API stored for the setting.
+func testAccCheckClusterSettingValue(s *fakeacm.Server, ID *string, want string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		st, ok := s.ClusterSetting(*ID)
+		if !ok {
+			return fmt.Errorf("cluster setting %s not found in the API", *ID)
+		}
+		if st.Value != want {
+			return fmt.Errorf("cluster setting value is %q, want %q", st.Value, want)
+		}
+		return nil
+	}
+}
+
+// testAccCheckClusterRestarts - checks how many times the test cluster was restarted.
+func testAccCheckClusterRestarts(s *fakeacm.Server, want int) resource.TestCheckFunc {
+	return func(st *terraform.State) error {
+		rs, ok := st.RootModule().Resources["altinitycloud_cluster.test"]
+		if !ok {
+			return fmt.Errorf("altinitycloud_cluster.test not found in state")
+		}
+		if got := s.Restarts(rs.Primary.ID); got != want {
+			return fmt.Errorf("cluster was restarted %d times, want %d", got, want)
+		}
+		return nil
+	}
+}
+
+// testAccClusterSettingImportID - builds the "<cluster_id>/<setting_name>" import ID.
+func testAccClusterSettingImportID(st *terraform.State) (string, error) {
+	rs, ok := st.RootModule().Resources["altinitycloud_cluster_setting.test"]
+	if !ok {
+		return "", fmt.Errorf("altinitycloud_cluster_setting.test not found in state")
+	}
+	return rs.Primary.Attributes["cluster_id"] + "/" + rs.Primary.Attributes["name"], nil
+}
+
+func testAccClusterSettingResourceConfig(setting string) string {
+	return fmt.Sprintf(`
+resource "altinitycloud_cluster" "test" {
+  env_id         = "648"
+  name           = "tf-acc"
+  version        = "24.3.5.47.altinitystable"
+  node_type      = "m6i.xlarge"
+  disk_size      = 100
+  admin_password = "admin-secret"
+}
+
+resource "altinitycloud_cluster_setting" "test" {
+  cluster_id = altinitycloud_cluster.test.id
+  name       = "logger/level"
+  %s
+}
+`, setting)
+}
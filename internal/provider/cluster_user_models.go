@@ -0,0 +1,18 @@
+package provider
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// ClusterUserResourceModel - describes the ClickHouse cluster user model for resources.
+type ClusterUserResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	ClusterID         types.String `tfsdk:"cluster_id"`
+	Username          types.String `tfsdk:"username"`
+	Password          types.String `tfsdk:"password"`
+	PasswordWO        types.String `tfsdk:"password_wo"`
+	PasswordWOVersion types.Int64  `tfsdk:"password_wo_version"`
+	Profile           types.String `tfsdk:"profile"`
+	Quota             types.String `tfsdk:"quota"`
+	Networks          types.List   `tfsdk:"networks"`
+	Databases         types.List   `tfsdk:"databases"`
+	LastUpdated       types.String `tfsdk:"last_updated"`
+}
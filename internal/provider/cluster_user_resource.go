@@ -0,0 +1,368 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/tatari-tv/terraform-provider-altinitycloud/cmd/client"
+	"time"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                     = &clusterUserResource{}
+	_ resource.ResourceWithConfigure        = &clusterUserResource{}
+	_ resource.ResourceWithImportState      = &clusterUserResource{}
+	_ resource.ResourceWithConfigValidators = &clusterUserResource{}
+)
+
+// NewClusterUserResource is a helper function to simplify the provider implementation.
+func NewClusterUserResource() resource.Resource {
+	return &clusterUserResource{}
+}
+
+// clusterUserResource is the resource implementation.
+type clusterUserResource struct {
+	client *client.AltinityCloudClient
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *clusterUserResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.AltinityCloudClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Cluster User Source Configure Type",
+			fmt.Sprintf("Expected *client.AltinityCloudClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Metadata - returns the resource type name.
+func (r *clusterUserResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_user"
+}
+
+// Schema - defines the schema for the resource.
+func (r *clusterUserResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a ClickHouse user of an Altinity.Cloud cluster.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Altinity.Cloud cluster user ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cluster_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Altinity.Cloud cluster ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"username": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "ClickHouse user name.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"password": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "ClickHouse user password, stored in the Terraform state. Exactly one of `password` or `password_wo` must be set.",
+			},
+			"password_wo": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				MarkdownDescription: "ClickHouse user password that is never stored in the Terraform state, requires Terraform 1.11 or later. Change `password_wo_version` to update it.",
+			},
+			"password_wo_version": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Version of `password_wo`, changing it updates the password.",
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("password_wo")),
+				},
+			},
+			"profile": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "ClickHouse settings profile of the user. Defaults to `default`.",
+				Default:             stringdefault.StaticString("default"),
+			},
+			"quota": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "ClickHouse quota of the user. Defaults to `default`.",
+				Default:             stringdefault.StaticString("default"),
+			},
+			"networks": schema.ListAttribute{
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "IP addresses and CIDR blocks the user may connect from. Defaults to `[\"::/0\"]`, any address, also when removed from the configuration.",
+				Default:             listdefault.StaticValue(mapStringList([]string{"::/0"})),
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(ipOrCIDR()),
+				},
+			},
+			"databases": schema.ListAttribute{
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Databases the user may access. Defaults to `[]`, all databases, also when removed from the configuration.",
+				Default:             listdefault.StaticValue(mapStringList(nil)),
+			},
+			"last_updated": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Altinity.Cloud cluster user last updated timestamp. This is auto-generated by the provider.",
+			},
+		},
+	}
+}
+
+// ConfigValidators - requires exactly one of password or password_wo.
+func (r *clusterUserResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("password"),
+			path.MatchRoot("password_wo"),
+		),
+	}
+}
+
+// Create - creates the cluster user and sets the initial Terraform state.
+func (r *clusterUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating cluster user resource")
+	// Retrieve values from plan, write-only values are only available in the config
+	var plan ClusterUserResourceModel
+	var passwordWO types.String
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password_wo"), &passwordWO)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Failed to retrieve plan for Altinity.Cloud cluster user resource")
+		return
+	}
+
+	reqData, diags := mapClusterUserModelToClusterUser(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	reqData.Password = plan.Password.ValueString()
+	if !passwordWO.IsNull() {
+		reqData.Password = passwordWO.ValueString()
+	}
+	ctx = maskSecrets(ctx, reqData.Password)
+
+	// Create new cluster user
+	tflog.Info(ctx, fmt.Sprintf("Creating user %s in cluster %s", plan.Username.ValueString(), plan.ClusterID.ValueString()))
+	user, err := r.client.CreateClusterUser(ctx, plan.ClusterID.ValueString(), reqData)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error creating cluster user", "Could not create cluster user", err, clusterUserFieldPaths)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	mapClusterUserToClusterUserModel(user, &plan)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	// Set plan to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *clusterUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Read Altinity.Cloud cluster user resource")
+	// Get current state
+	var state ClusterUserResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = maskSecrets(ctx, state.Password.ValueString())
+
+	// Get refreshed cluster user from Altinity.Cloud
+	user, err := r.client.GetClusterUser(ctx, state.ID.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, fmt.Sprintf("cluster user %s not found, removing it from state", state.ID.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error retrieving cluster user", "Could not retrieve cluster user", err, nil)
+		return
+	}
+
+	// Overwrite current state with refreshed data
+	mapClusterUserToClusterUserModel(user, &state)
+
+	tflog.Trace(ctx, fmt.Sprintf("refreshed cluster user %s from API", state.ID.ValueString()))
+
+	// set refreshed state
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update - updates the cluster user and sets the updated Terraform state on success.
+// The password is only sent when it changed, or when password_wo_version changed.
+func (r *clusterUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Update cluster user resource")
+	// Retrieve values from plan and state, write-only values are only available in the config
+	var plan, state ClusterUserResourceModel
+	var passwordWO types.String
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password_wo"), &passwordWO)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Failed to retrieve plan for Altinity.Cloud cluster user resource")
+		return
+	}
+
+	reqData, diags := mapClusterUserModelToClusterUser(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	switch {
+	case !plan.Password.IsNull() && !plan.Password.Equal(state.Password):
+		reqData.Password = plan.Password.ValueString()
+	case !passwordWO.IsNull() && (!plan.PasswordWOVersion.Equal(state.PasswordWOVersion) || !state.Password.IsNull()):
+		reqData.Password = passwordWO.ValueString()
+	}
+	ctx = maskSecrets(ctx, reqData.Password, state.Password.ValueString())
+
+	// Update cluster user in Altinity.Cloud
+	tflog.Info(ctx, fmt.Sprintf("Updating cluster user %s", plan.ID.ValueString()))
+	user, err := r.client.UpdateClusterUser(ctx, reqData)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error updating cluster user", "Could not update cluster user", err, clusterUserFieldPaths)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	mapClusterUserToClusterUserModel(user, &plan)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	// Set plan to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete - deletes the cluster user and removes the Terraform state on success.
+func (r *clusterUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Delete cluster user resource")
+	// Retrieve values from state
+	var state ClusterUserResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = maskSecrets(ctx, state.Password.ValueString())
+
+	// Delete existing cluster user, it may already be gone
+	err := r.client.DeleteClusterUser(ctx, state.ID.ValueString())
+	if client.IsNotFound(err) {
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error deleting cluster user", "Could not delete cluster user", err, nil)
+		return
+	}
+}
+
+// ImportState - imports an existing cluster user by its Altinity.Cloud ID. The password
+// is never returned by the API, so it has to be set in the configuration after the import.
+func (r *clusterUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, "Import cluster user resource")
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// maskSecrets - hides the given secrets from every log written with the returned context.
+func maskSecrets(ctx context.Context, secrets ...string) context.Context {
+	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "password", "password_wo")
+	for _, s := range secrets {
+		if s != "" {
+			ctx = tflog.MaskAllFieldValuesStrings(ctx, s)
+			ctx = tflog.MaskMessageStrings(ctx, s)
+		}
+	}
+	return ctx
+}
+
+// mapClusterUserModelToClusterUser - converts the Terraform model into an API request without the password.
+func mapClusterUserModelToClusterUser(ctx context.Context, m ClusterUserResourceModel) (client.ClusterUser, diag.Diagnostics) {
+	user := client.ClusterUser{
+		ID:        m.ID.ValueString(),
+		ClusterID: m.ClusterID.ValueString(),
+		Name:      m.Username.ValueString(),
+		Profile:   m.Profile.ValueString(),
+		Quota:     m.Quota.ValueString(),
+	}
+
+	var diags diag.Diagnostics
+	if !m.Networks.IsNull() && !m.Networks.IsUnknown() {
+		diags.Append(m.Networks.ElementsAs(ctx, &user.Networks, false)...)
+	}
+	if !m.Databases.IsNull() && !m.Databases.IsUnknown() {
+		// an empty list is sent to grant all databases again
+		user.Databases = []string{}
+		diags.Append(m.Databases.ElementsAs(ctx, &user.Databases, false)...)
+	}
+
+	return user, diags
+}
+
+// mapClusterUserToClusterUserModel - copies the API response into the Terraform model.
+// The password is never returned by the API, so it is kept as is.
+func mapClusterUserToClusterUserModel(user client.ClusterUser, m *ClusterUserResourceModel) {
+	m.ID = types.StringValue(user.ID)
+	m.Username = types.StringValue(user.Name)
+	m.Profile = types.StringValue(user.Profile)
+	m.Quota = types.StringValue(user.Quota)
+	m.Networks = mapStringList(user.Networks)
+	m.Databases = mapStringList(user.Databases)
+
+	// the cluster is only known from the API after an import
+	if len(user.ClusterID) > 0 {
+		m.ClusterID = types.StringValue(user.ClusterID)
+	}
+}
@@ -0,0 +1,188 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/stretchr/testify/assert"
+	"github.com/tatari-tv/terraform-provider-altinitycloud/cmd/client"
+	"github.com/tatari-tv/terraform-provider-altinitycloud/internal/fakeacm"
+	"slices"
+	"testing"
+)
+
+func TestMapClusterUserToClusterUserModel(t *testing.T) {
+	ctx := context.Background()
+	u := client.ClusterUser{
+		ID:        "7",
+		ClusterID: "42",
+		Name:      "app",
+		Profile:   "readonly",
+		Quota:     "default",
+		Networks:  []string{"10.0.0.0/8"},
+		Databases: []string{"events", "users"},
+	}
+
+	m := ClusterUserResourceModel{Password: types.StringValue("secret")}
+	mapClusterUserToClusterUserModel(u, &m)
+	assert.Equal(t, "42", m.ClusterID.ValueString())
+	assert.Equal(t, "app", m.Username.ValueString())
+	assert.Len(t, m.Databases.Elements(), 2)
+	// the password is never returned by the API
+	assert.Equal(t, "secret", m.Password.ValueString())
+
+	req, diags := mapClusterUserModelToClusterUser(ctx, m)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, u.Networks, req.Networks)
+	assert.Equal(t, u.Databases, req.Databases)
+	assert.Empty(t, req.Password)
+}
+
+func TestAccClusterUserResource(t *testing.T) {
+	s := fakeacm.NewServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig(s) + testAccClusterUserResourceConfig(`password = "secret"`, `networks = ["10.0.0.0/8"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("altinitycloud_cluster_user.test", "id"),
+					resource.TestCheckResourceAttr("altinitycloud_cluster_user.test", "profile", "default"),
+					resource.TestCheckResourceAttr("altinitycloud_cluster_user.test", "databases.#", "0"),
+					testAccCheckClusterUserPassword(s, "secret"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "altinitycloud_cluster_user.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated", "password"},
+			},
+			// Update networks without touching the password, then change the password
+			{
+				Config: testAccProviderConfig(s) + testAccClusterUserResourceConfig(`password = "secret"`, `networks = ["10.0.0.0/8", "192.168.1.1"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("altinitycloud_cluster_user.test", "networks.#", "2"),
+					testAccCheckClusterUserPassword(s, "secret"),
+				),
+			},
+			{
+				Config: testAccProviderConfig(s) + testAccClusterUserResourceConfig(`password = "changed"`, `networks = ["10.0.0.0/8", "192.168.1.1"]`),
+				Check:  testAccCheckClusterUserPassword(s, "changed"),
+			},
+			{
+				Config: testAccProviderConfig(s) + testAccClusterUserResourceConfig(`password = "changed"`, `networks  = ["10.0.0.0/8"]
+  databases = ["default", "analytics"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("altinitycloud_cluster_user.test", "databases.#", "2"),
+					testAccCheckClusterUserAccess(s, []string{"10.0.0.0/8"}, []string{"default", "analytics"}),
+				),
+			},
+			// Removing networks and databases from the configuration resets them to their defaults
+			{
+				Config: testAccProviderConfig(s) + testAccClusterUserResourceConfig(`password = "changed"`, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("altinitycloud_cluster_user.test", "networks.#", "1"),
+					resource.TestCheckResourceAttr("altinitycloud_cluster_user.test", "networks.0", "::/0"),
+					resource.TestCheckResourceAttr("altinitycloud_cluster_user.test", "databases.#", "0"),
+					testAccCheckClusterUserAccess(s, []string{"::/0"}, []string{}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccClusterUserResourceWriteOnly(t *testing.T) {
+	s := fakeacm.NewServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.11.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(s) + testAccClusterUserResourceConfig(`password_wo = "secret"
+  password_wo_version = 1`, `networks = ["10.0.0.0/8"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("altinitycloud_cluster_user.test", "password_wo"),
+					testAccCheckClusterUserPassword(s, "secret"),
+				),
+			},
+			// a new password is only sent when the version changes
+			{
+				Config: testAccProviderConfig(s) + testAccClusterUserResourceConfig(`password_wo = "changed"
+  password_wo_version = 1`, `networks = ["10.0.0.0/8"]`),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccProviderConfig(s) + testAccClusterUserResourceConfig(`password_wo = "changed"
+  password_wo_version = 2`, `networks = ["10.0.0.0/8"]`),
+				Check: testAccCheckClusterUserPassword(s, "changed"),
+			},
+		},
+	})
+}
+
+// testAccCheckClusterUserPassword - checks the password the fake API stored for the test user.
+func testAccCheckClusterUserPassword(s *fakeacm.Server, want string) resource.TestCheckFunc {
+	return func(st *terraform.State) error {
+		rs, ok := st.RootModule().Resources["altinitycloud_cluster_user.test"]
+		if !ok {
+			return fmt.Errorf("altinitycloud_cluster_user.test not found in state")
+		}
+		u, ok := s.ClusterUser(rs.Primary.ID)
+		if !ok {
+			return fmt.Errorf("cluster user %s not found in the API", rs.Primary.ID)
+		}
+		if u.Password != want {
+			return fmt.Errorf("cluster user password is %q, want %q", u.Password, want)
+		}
+		return nil
+	}
+}
+
+// testAccCheckClusterUserAccess - checks the networks and databases the fake API stored for the test user.
+func testAccCheckClusterUserAccess(s *fakeacm.Server, networks, databases []string) resource.TestCheckFunc {
+	return func(st *terraform.State) error {
+		rs, ok := st.RootModule().Resources["altinitycloud_cluster_user.test"]
+		if !ok {
+			return fmt.Errorf("altinitycloud_cluster_user.test not found in state")
+		}
+		u, ok := s.ClusterUser(rs.Primary.ID)
+		if !ok {
+			return fmt.Errorf("cluster user %s not found in the API", rs.Primary.ID)
+		}
+		if !slices.Equal(u.Networks, networks) || !slices.Equal(u.Databases, databases) {
+			return fmt.Errorf("cluster user networks %v and databases %v, want %v and %v", u.Networks, u.Databases, networks, databases)
+		}
+		return nil
+	}
+}
+
+func testAccClusterUserResourceConfig(password, access string) string {
+	return fmt.Sprintf(`
+resource "altinitycloud_cluster" "test" {
+  env_id         = "648"
+  name           = "tf-acc"
+  version        = "24.3.5.47.altinitystable"
+  node_type      = "m6i.xlarge"
+  disk_size      = 100
+  admin_password = "admin-secret"
+}
+
+resource "altinitycloud_cluster_user" "test" {
+  cluster_id = altinitycloud_cluster.test.id
+  username   = "app"
+  %s
+  %s
+}
+`, password, access)
+}
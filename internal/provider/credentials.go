@@ -0,0 +1,220 @@
+package provider
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const (
+	// defaultCredentialsFile - credentials file read when neither credentials_file nor
+	// ALTINITY_CLOUD_CREDENTIALS_FILE are set, relative to the home directory.
+	defaultCredentialsFile = ".altinity/credentials"
+	// defaultProfile - profile of the credentials file used when no profile is selected.
+	defaultProfile = "default"
+)
+
+// Where the API endpoint and token were taken from, logged by Configure.
+const (
+	credentialsSourceConfig      = "config"
+	credentialsSourceProfile     = "profile"
+	credentialsSourceEnvironment = "environment"
+)
+
+// credentialsProfile - Altinity.Cloud API endpoint and token of a named profile in the credentials file.
+type credentialsProfile struct {
+	APIEndpoint string
+	APIToken    string
+}
+
+// apiCredentials - Altinity.Cloud API endpoint and token resolved from the configuration,
+// the credentials file and the environment, together with where each one came from.
+type apiCredentials struct {
+	Endpoint        string
+	EndpointSource  string
+	Token           string
+	TokenSource     string
+	Profile         string
+	CredentialsFile string
+}
+
+// parseCredentials - parses an INI style credentials file into profiles keyed by name, e.g.
+//
+//	[default]
+//	api_endpoint = https://acm.altinity.cloud/api
+//	api_token    = ...
+func parseCredentials(r io.Reader) (map[string]credentialsProfile, error) {
+	profiles := map[string]credentialsProfile{}
+	section := ""
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") {
+			if !strings.HasSuffix(line, "]") || len(strings.TrimSpace(line[1:len(line)-1])) == 0 {
+				return nil, fmt.Errorf("line %d: invalid profile header %q", n, line)
+			}
+			section = strings.TrimSpace(line[1 : len(line)-1])
+			profiles[section] = profiles[section]
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("line %d: expected key = value", n)
+		}
+		if section == "" {
+			return nil, fmt.Errorf("line %d: %s is not in a [profile] section", n, strings.TrimSpace(key))
+		}
+
+		p := profiles[section]
+		switch key = strings.TrimSpace(key); key {
+		case "api_endpoint":
+			p.APIEndpoint = strings.TrimSpace(value)
+		case "api_token":
+			p.APIToken = strings.TrimSpace(value)
+		default:
+			return nil, fmt.Errorf("line %d: unknown key %s, expected api_endpoint or api_token", n, key)
+		}
+		profiles[section] = p
+	}
+
+	return profiles, scanner.Err()
+}
+
+// loadCredentials - reads and parses the credentials file.
+func loadCredentials(filename string) (map[string]credentialsProfile, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	profiles, err := parseCredentials(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", filename, err)
+	}
+	return profiles, nil
+}
+
+// resolveAPICredentials - resolves the API endpoint and token, each one is taken from the first of
+//  1. the api_endpoint and api_token attributes,
+//  2. the profile selected by the profile attribute or ALTINITY_CLOUD_PROFILE,
+//  3. the ALTINITY_CLOUD_ENDPOINT and ALTINITY_CLOUD_TOKEN environment variables,
+//  4. the default profile, when no profile is selected,
+//
+// that sets it. The credentials file is only required to exist when it or a profile is selected explicitly.
+func resolveAPICredentials(config altinityCloudProviderModel) (apiCredentials, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	c := apiCredentials{
+		Profile:         os.Getenv("ALTINITY_CLOUD_PROFILE"),
+		CredentialsFile: os.Getenv("ALTINITY_CLOUD_CREDENTIALS_FILE"),
+	}
+	if !config.Profile.IsNull() {
+		c.Profile = config.Profile.ValueString()
+	}
+	if !config.CredentialsFile.IsNull() {
+		c.CredentialsFile = config.CredentialsFile.ValueString()
+	}
+
+	// the default credentials file is optional, one that is set explicitly must exist
+	fileRequired := c.CredentialsFile != "" || c.Profile != ""
+	if c.CredentialsFile == "" {
+		if home, err := os.UserHomeDir(); err == nil {
+			c.CredentialsFile = filepath.Join(home, defaultCredentialsFile)
+		}
+	}
+
+	// set - takes the value from the given source unless a previous source already set it
+	set := func(value, source *string, v, from string) {
+		if *source == "" && v != "" {
+			*value, *source = v, from
+		}
+	}
+
+	// explicitly configured attributes win even when empty, so a missing value is reported
+	if !config.APIEndpoint.IsNull() {
+		c.Endpoint, c.EndpointSource = config.APIEndpoint.ValueString(), credentialsSourceConfig
+	}
+	if !config.APIToken.IsNull() {
+		c.Token, c.TokenSource = config.APIToken.ValueString(), credentialsSourceConfig
+	}
+
+	// profile - returns the named profile, or false with a diagnostic when it cannot be read
+	profile := func(name string) (credentialsProfile, bool) {
+		profiles, err := loadCredentials(c.CredentialsFile)
+		if errors.Is(err, os.ErrNotExist) && !fileRequired {
+			return credentialsProfile{}, false
+		}
+		if err != nil {
+			diags.AddAttributeError(
+				credentialsAttribute(config),
+				"Unable to Read Altinity.Cloud Credentials File",
+				"The provider cannot create the Altinity.Cloud API client as the credentials file could not be read: "+err.Error(),
+			)
+			return credentialsProfile{}, false
+		}
+		p, ok := profiles[name]
+		if !ok && c.Profile != "" {
+			diags.AddAttributeError(
+				path.Root("profile"),
+				"Unknown Altinity.Cloud Profile",
+				fmt.Sprintf("The provider cannot create the Altinity.Cloud API client as profile %q is not in the credentials file %s, available profiles: %s.",
+					name, c.CredentialsFile, strings.Join(profileNames(profiles), ", ")),
+			)
+		}
+		return p, ok
+	}
+
+	if c.Profile != "" {
+		if p, ok := profile(c.Profile); ok {
+			set(&c.Endpoint, &c.EndpointSource, p.APIEndpoint, credentialsSourceProfile)
+			set(&c.Token, &c.TokenSource, p.APIToken, credentialsSourceProfile)
+		}
+	}
+
+	set(&c.Endpoint, &c.EndpointSource, os.Getenv("ALTINITY_CLOUD_ENDPOINT"), credentialsSourceEnvironment)
+	set(&c.Token, &c.TokenSource, os.Getenv("ALTINITY_CLOUD_TOKEN"), credentialsSourceEnvironment)
+
+	// the default profile is only read, and a broken file only reported, when a value is still missing
+	if c.Profile == "" && (c.EndpointSource == "" || c.TokenSource == "") {
+		if p, ok := profile(defaultProfile); ok {
+			c.Profile = defaultProfile
+			set(&c.Endpoint, &c.EndpointSource, p.APIEndpoint, credentialsSourceProfile)
+			set(&c.Token, &c.TokenSource, p.APIToken, credentialsSourceProfile)
+		}
+	}
+
+	return c, diags
+}
+
+// credentialsAttribute - returns the attribute credentials file errors are reported on.
+func credentialsAttribute(config altinityCloudProviderModel) path.Path {
+	if !config.CredentialsFile.IsNull() || config.Profile.IsNull() {
+		return path.Root("credentials_file")
+	}
+	return path.Root("profile")
+}
+
+// profileNames - returns the profile names of a credentials file in alphabetical order.
+func profileNames(profiles map[string]credentialsProfile) []string {
+	names := make([]string, 0, len(profiles))
+	for name := range profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	if len(names) == 0 {
+		return []string{"none"}
+	}
+	return names
+}
@@ -0,0 +1,225 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/tatari-tv/terraform-provider-altinitycloud/internal/fakeacm"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+const testCredentials = `
+# Altinity.Cloud organizations
+[default]
+api_endpoint = https://acm.altinity.cloud/api
+api_token    = default-token
+
+[self-hosted]
+; token only, the endpoint comes from the environment
+api_token = self-hosted-token
+`
+
+func TestParseCredentials(t *testing.T) {
+	profiles, err := parseCredentials(strings.NewReader(testCredentials))
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]credentialsProfile{
+		"default":     {APIEndpoint: "https://acm.altinity.cloud/api", APIToken: "default-token"},
+		"self-hosted": {APIToken: "self-hosted-token"},
+	}, profiles)
+
+	for content, want := range map[string]string{
+		"api_token = x":           "line 1: api_token is not in a [profile] section",
+		"[default]\napi_key = x":  "line 2: unknown key api_key, expected api_endpoint or api_token",
+		"[default]\napi_token":    "line 2: expected key = value",
+		"[default\napi_token = x": `line 1: invalid profile header "[default"`,
+		"[ ]":                     `line 1: invalid profile header "[ ]"`,
+	} {
+		_, err := parseCredentials(strings.NewReader(content))
+		assert.EqualError(t, err, want, content)
+	}
+}
+
+// testCredentialsEnv - isolates the test from the credentials and environment of the user running it,
+// and returns the path of the default credentials file in the temporary home directory.
+func testCredentialsEnv(t *testing.T, content string) string {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	for _, name := range []string{"ALTINITY_CLOUD_ENDPOINT", "ALTINITY_CLOUD_TOKEN", "ALTINITY_CLOUD_PROFILE", "ALTINITY_CLOUD_CREDENTIALS_FILE"} {
+		t.Setenv(name, "")
+	}
+
+	filename := filepath.Join(home, defaultCredentialsFile)
+	if content != "" {
+		assert.Nil(t, os.MkdirAll(filepath.Dir(filename), 0o700))
+		assert.Nil(t, os.WriteFile(filename, []byte(content), 0o600))
+	}
+	return filename
+}
+
+// testProviderModel - returns a provider configuration with the given string attributes set.
+func testProviderModel(attrs map[string]string) altinityCloudProviderModel {
+	value := func(name string) types.String {
+		if v, ok := attrs[name]; ok {
+			return types.StringValue(v)
+		}
+		return types.StringNull()
+	}
+	return altinityCloudProviderModel{
+		APIEndpoint:     value("api_endpoint"),
+		APIToken:        value("api_token"),
+		Profile:         value("profile"),
+		CredentialsFile: value("credentials_file"),
+	}
+}
+
+func TestResolveAPICredentials(t *testing.T) {
+	t.Run("environment beats the default profile", func(t *testing.T) {
+		testCredentialsEnv(t, testCredentials)
+		t.Setenv("ALTINITY_CLOUD_TOKEN", "env-token")
+
+		c, diags := resolveAPICredentials(testProviderModel(nil))
+		assert.False(t, diags.HasError())
+		assert.Equal(t, "https://acm.altinity.cloud/api", c.Endpoint)
+		assert.Equal(t, credentialsSourceProfile, c.EndpointSource)
+		assert.Equal(t, "env-token", c.Token)
+		assert.Equal(t, credentialsSourceEnvironment, c.TokenSource)
+		assert.Equal(t, defaultProfile, c.Profile)
+	})
+
+	t.Run("selected profile beats the environment", func(t *testing.T) {
+		testCredentialsEnv(t, testCredentials)
+		t.Setenv("ALTINITY_CLOUD_PROFILE", "self-hosted")
+		t.Setenv("ALTINITY_CLOUD_ENDPOINT", "https://acm.example.com/api")
+		t.Setenv("ALTINITY_CLOUD_TOKEN", "env-token")
+
+		c, diags := resolveAPICredentials(testProviderModel(nil))
+		assert.False(t, diags.HasError())
+		assert.Equal(t, "https://acm.example.com/api", c.Endpoint, "the profile has no endpoint, so it falls through")
+		assert.Equal(t, credentialsSourceEnvironment, c.EndpointSource)
+		assert.Equal(t, "self-hosted-token", c.Token)
+		assert.Equal(t, credentialsSourceProfile, c.TokenSource)
+	})
+
+	t.Run("configuration beats the selected profile", func(t *testing.T) {
+		testCredentialsEnv(t, testCredentials)
+		t.Setenv("ALTINITY_CLOUD_PROFILE", "self-hosted")
+
+		c, diags := resolveAPICredentials(testProviderModel(map[string]string{"profile": "default", "api_token": "config-token"}))
+		assert.False(t, diags.HasError())
+		assert.Equal(t, "default", c.Profile, "the profile attribute beats ALTINITY_CLOUD_PROFILE")
+		assert.Equal(t, "https://acm.altinity.cloud/api", c.Endpoint)
+		assert.Equal(t, "config-token", c.Token)
+		assert.Equal(t, credentialsSourceConfig, c.TokenSource)
+	})
+
+	t.Run("missing default file is ignored", func(t *testing.T) {
+		testCredentialsEnv(t, "")
+		t.Setenv("ALTINITY_CLOUD_ENDPOINT", "https://acm.example.com/api")
+
+		c, diags := resolveAPICredentials(testProviderModel(nil))
+		assert.False(t, diags.HasError())
+		assert.Equal(t, "https://acm.example.com/api", c.Endpoint)
+		assert.Empty(t, c.TokenSource)
+		assert.Empty(t, c.Profile)
+	})
+
+	t.Run("environment only ignores a broken default file", func(t *testing.T) {
+		testCredentialsEnv(t, "api_token = outside of a profile\n")
+		t.Setenv("ALTINITY_CLOUD_ENDPOINT", "https://acm.example.com/api")
+		t.Setenv("ALTINITY_CLOUD_TOKEN", "env-token")
+
+		c, diags := resolveAPICredentials(testProviderModel(nil))
+		assert.False(t, diags.HasError())
+		assert.Equal(t, credentialsSourceEnvironment, c.EndpointSource)
+		assert.Equal(t, credentialsSourceEnvironment, c.TokenSource)
+		assert.Empty(t, c.Profile)
+	})
+
+	t.Run("broken default file is reported when a value is missing", func(t *testing.T) {
+		testCredentialsEnv(t, "api_token = outside of a profile\n")
+		t.Setenv("ALTINITY_CLOUD_ENDPOINT", "https://acm.example.com/api")
+
+		_, diags := resolveAPICredentials(testProviderModel(nil))
+		assert.True(t, diags.HasError())
+		assert.Contains(t, diags[0].Detail(), "api_token is not in a [profile] section")
+	})
+
+	t.Run("selected profile requires the file", func(t *testing.T) {
+		testCredentialsEnv(t, "")
+
+		_, diags := resolveAPICredentials(testProviderModel(map[string]string{"profile": "default"}))
+		assert.True(t, diags.HasError())
+		assert.Equal(t, "Unable to Read Altinity.Cloud Credentials File", diags[0].Summary())
+	})
+
+	t.Run("unknown profile lists the available ones", func(t *testing.T) {
+		testCredentialsEnv(t, testCredentials)
+
+		_, diags := resolveAPICredentials(testProviderModel(map[string]string{"profile": "staging"}))
+		assert.True(t, diags.HasError())
+		assert.Contains(t, diags[0].Detail(), `profile "staging" is not in the credentials file`)
+		assert.Contains(t, diags[0].Detail(), "available profiles: default, self-hosted.")
+	})
+}
+
+func TestAccProviderProfile(t *testing.T) {
+	s := fakeacm.NewServer(t)
+	filename := testCredentialsEnv(t, fmt.Sprintf("[fake]\napi_endpoint = %s\napi_token = %s\n", s.URL, fakeacm.Token))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProviderProfileConfig(filename, "staging"),
+				ExpectError: regexp.MustCompile(`Unknown Altinity.Cloud Profile`),
+			},
+			// The endpoint and token of the profile reach the fake API
+			{
+				Config: testAccProviderProfileConfig(filename, "fake"),
+				Check:  resource.TestCheckResourceAttr("data.altinitycloud_backups.test", "backups.#", "0"),
+			},
+		},
+	})
+}
+
+func TestAccProviderEnvironmentBrokenCredentialsFile(t *testing.T) {
+	s := fakeacm.NewServer(t)
+	testCredentialsEnv(t, "not a credentials file\n")
+	t.Setenv("ALTINITY_CLOUD_ENDPOINT", s.URL)
+	t.Setenv("ALTINITY_CLOUD_TOKEN", fakeacm.Token)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The environment sets both values, so the default credentials file is never read
+			{
+				Config: `
+provider "altinitycloud" {}
+
+data "altinitycloud_backups" "test" {
+  cluster_id = "42"
+}
+`,
+				Check: resource.TestCheckResourceAttr("data.altinitycloud_backups.test", "backups.#", "0"),
+			},
+		},
+	})
+}
+
+func testAccProviderProfileConfig(filename, profile string) string {
+	return fmt.Sprintf(`
+provider "altinitycloud" {
+  credentials_file = %q
+  profile          = %q
+}
+
+data "altinitycloud_backups" "test" {
+  cluster_id = "42"
+}
+`, filename, profile)
+}
@@ -0,0 +1,162 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/tatari-tv/terraform-provider-altinitycloud/cmd/client"
+)
+
+// nodeTypeFieldPaths - maps Altinity.Cloud API node type fields to resource attribute paths.
+var nodeTypeFieldPaths = map[string]path.Path{
+	"name":         path.Root("node_type").AtName("name"),
+	"scope":        path.Root("node_type").AtName("scope"),
+	"code":         path.Root("node_type").AtName("code"),
+	"pool":         path.Root("node_type").AtName("pool"),
+	"storageClass": path.Root("node_type").AtName("storage_class"),
+	"cpu":          path.Root("node_type").AtName("cpu"),
+	"memory":       path.Root("node_type").AtName("memory"),
+	"extraSpec":    path.Root("node_type").AtName("extra_spec"),
+	"nodeSelector": path.Root("node_type").AtName("node_selector"),
+	"tolerations":  path.Root("node_type").AtName("tolerations"),
+}
+
+// clusterFieldPaths - maps Altinity.Cloud API cluster fields to resource attribute paths.
+var clusterFieldPaths = map[string]path.Path{
+	"name":      path.Root("name"),
+	"version":   path.Root("version"),
+	"nodeType":  path.Root("node_type"),
+	"shards":    path.Root("shards"),
+	"replicas":  path.Root("replicas"),
+	"size":      path.Root("disk_size"),
+	"zookeeper": path.Root("zookeeper"),
+	"adminUser": path.Root("admin_user"),
+	"adminPass": path.Root("admin_password"),
+}
+
+// restoreFieldPaths - maps Altinity.Cloud API restore fields to resource attribute paths.
+var restoreFieldPaths = map[string]path.Path{
+	"backup": path.Root("restore_from"),
+}
+
+// environmentFieldPaths - maps Altinity.Cloud API environment fields to resource attribute paths.
+var environmentFieldPaths = map[string]path.Path{
+	"name":               path.Root("name"),
+	"cloud":              path.Root("cloud"),
+	"region":             path.Root("region"),
+	"zones":              path.Root("zones"),
+	"nodeTypeClickHouse": path.Root("default_clickhouse_node_type"),
+	"nodeTypeZookeeper":  path.Root("default_zookeeper_node_type"),
+}
+
+// clusterUserFieldPaths - maps Altinity.Cloud API cluster user fields to resource attribute paths.
+var clusterUserFieldPaths = map[string]path.Path{
+	"name":      path.Root("username"),
+	"profile":   path.Root("profile"),
+	"quota":     path.Root("quota"),
+	"networks":  path.Root("networks"),
+	"databases": path.Root("databases"),
+}
+
+// clusterSettingFieldPaths - maps Altinity.Cloud API cluster setting fields to resource attribute paths.
+var clusterSettingFieldPaths = map[string]path.Path{
+	"name":            path.Root("name"),
+	"value":           path.Root("value"),
+	"file":            path.Root("file_content"),
+	"restartRequired": path.Root("restart_required"),
+}
+
+// clusterProfileFieldPaths - maps Altinity.Cloud API cluster profile fields to resource attribute paths.
+var clusterProfileFieldPaths = map[string]path.Path{
+	"name":        path.Root("name"),
+	"description": path.Root("description"),
+	"settings":    path.Root("settings"),
+}
+
+// clusterScheduleFieldPaths - maps Altinity.Cloud API cluster schedule fields to resource attribute paths.
+var clusterScheduleFieldPaths = map[string]path.Path{
+	"mode":          path.Root("mode"),
+	"inactiveHours": path.Root("inactive_hours"),
+	"timezone":      path.Root("timezone"),
+	"days":          path.Root("days"),
+}
+
+// clusterIPAllowlistFieldPaths - maps Altinity.Cloud API cluster IP allowlist fields to resource attribute paths.
+var clusterIPAllowlistFieldPaths = map[string]path.Path{
+	"entries": path.Root("entries"),
+}
+
+// privateEndpointServiceFieldPaths - maps Altinity.Cloud API private endpoint service fields to resource attribute paths.
+var privateEndpointServiceFieldPaths = map[string]path.Path{
+	"environment":       path.Root("env_id"),
+	"allowedPrincipals": path.Root("allowed_principals"),
+}
+
+// privateEndpointConnectionFieldPaths - maps Altinity.Cloud API private endpoint connection fields to resource attribute paths.
+var privateEndpointConnectionFieldPaths = map[string]path.Path{
+	"endpointId":  path.Root("endpoint_id"),
+	"description": path.Root("description"),
+}
+
+// backupScheduleFieldPaths - maps Altinity.Cloud API backup schedule fields to resource attribute paths.
+var backupScheduleFieldPaths = map[string]path.Path{
+	"schedule":    path.Root("schedule"),
+	"retention":   path.Root("retention"),
+	"bucket":      path.Root("bucket"),
+	"compression": path.Root("compression"),
+}
+
+// backupFieldPaths - maps Altinity.Cloud API backup fields to resource attribute paths.
+var backupFieldPaths = map[string]path.Path{
+	"name": path.Root("name"),
+}
+
+// addClientError - appends an Altinity.Cloud client error to diagnostics. Field validation
+// errors of known fields are reported on the matching attribute, everything else as a
+// general error with the API error code and request ID.
+func addClientError(diags *diag.Diagnostics, summary, detail string, err error, fieldPaths map[string]path.Path) {
+	var apiErr *client.APIError
+	if !errors.As(err, &apiErr) {
+		diags.AddError(summary, detail+", unexpected error: "+err.Error())
+		return
+	}
+
+	switch {
+	case client.IsUnauthorized(err):
+		detail += ". The Altinity.Cloud API token is invalid or not allowed to perform this operation"
+	case client.IsConflict(err):
+		detail += ". The object was changed or already exists in Altinity.Cloud"
+	}
+
+	unmapped := false
+	for _, f := range apiErr.Fields {
+		p, ok := fieldPaths[f.Field]
+		if !ok {
+			unmapped = true
+			continue
+		}
+		diags.AddAttributeError(p, summary, fmt.Sprintf("%s: %s", detail, f.Message))
+	}
+
+	// all field errors were reported on attributes
+	if len(apiErr.Fields) > 0 && !unmapped {
+		return
+	}
+
+	diags.AddError(summary, detail+", unexpected error: "+apiErr.Error())
+}
+
+// addWaitError - appends an error of a long-running operation that did not reach its target status.
+// Timed out and interrupted waits leave the operation running in Altinity.Cloud, which is pointed
+// out so the changes seen on the next plan are expected.
+func addWaitError(diags *diag.Diagnostics, summary, detail string, err error) {
+	var waitErr *client.WaitError
+	switch {
+	case client.IsInterrupted(err):
+		detail += ". The apply was interrupted, the operation continues in Altinity.Cloud and the last known state is saved"
+	case errors.As(err, &waitErr):
+		detail += ". The operation continues in Altinity.Cloud, raise the `timeouts` of the resource if it needs more time"
+	}
+	addClientError(diags, summary, detail, err, nil)
+}
@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/stretchr/testify/assert"
+	"github.com/tatari-tv/terraform-provider-altinitycloud/cmd/client"
+	"testing"
+)
+
+func TestAddClientErrorFieldErrors(t *testing.T) {
+	var diags diag.Diagnostics
+	err := &client.APIError{
+		StatusCode: 422,
+		Message:    "invalid node type",
+		Fields: []client.FieldError{
+			{Field: "storageClass", Message: "unknown storage class"},
+		},
+	}
+
+	addClientError(&diags, "Error creating node type", "Could not create node type", err, nodeTypeFieldPaths)
+	assert.Len(t, diags, 1)
+
+	withPath, ok := diags[0].(diag.DiagnosticWithPath)
+	if !ok {
+		t.Fatalf("addClientError() diagnostic, want diag.DiagnosticWithPath got %T", diags[0])
+	}
+	assert.Equal(t, path.Root("node_type").AtName("storage_class"), withPath.Path())
+}
+
+func TestAddClientErrorUnknownField(t *testing.T) {
+	var diags diag.Diagnostics
+	err := &client.APIError{
+		StatusCode: 422,
+		Fields: []client.FieldError{
+			{Field: "storageClass", Message: "unknown storage class"},
+			{Field: "somethingElse", Message: "bad"},
+		},
+	}
+
+	addClientError(&diags, "Error creating node type", "Could not create node type", err, nodeTypeFieldPaths)
+	assert.Len(t, diags, 2, "unmapped fields should be reported as a general error")
+}
+
+func TestAddClientErrorPlainError(t *testing.T) {
+	var diags diag.Diagnostics
+	addClientError(&diags, "Error", "Could not do it", fmt.Errorf("boom"), nil)
+	assert.Len(t, diags, 1)
+	assert.Equal(t, "Could not do it, unexpected error: boom", diags[0].Detail())
+}
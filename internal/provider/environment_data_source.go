@@ -0,0 +1,206 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/tatari-tv/terraform-provider-altinitycloud/cmd/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                     = &environmentDataSource{}
+	_ datasource.DataSourceWithConfigure        = &environmentDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &environmentDataSource{}
+)
+
+func NewEnvironmentDataSource() datasource.DataSource {
+	return &environmentDataSource{}
+}
+
+// environmentDataSource - defines the environment data source implementation.
+type environmentDataSource struct {
+	client *client.AltinityCloudClient
+}
+
+// Metadata - returns the altinitycloud_environment type name.
+func (d *environmentDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_environment"
+}
+
+// Schema - defines the environment schema.
+func (d *environmentDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up an Altinity.Cloud environment by name or ID.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Altinity.Cloud environment ID. Exactly one of `id` or `name` must be set.",
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Altinity.Cloud environment name. Exactly one of `id` or `name` must be set.",
+			},
+			"cloud": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Cloud provider of the environment (`aws`, `gcp` or `azure`).",
+			},
+			"region": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Cloud provider region of the environment.",
+			},
+			"zones": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Availability zones used by the environment.",
+			},
+			"default_clickhouse_node_type": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Default node type of ClickHouse nodes.",
+			},
+			"default_zookeeper_node_type": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Default node type of ZooKeeper nodes.",
+			},
+			"kubernetes_version": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Kubernetes version of the environment.",
+			},
+			"kubernetes_namespace": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Kubernetes namespace the environment runs in.",
+			},
+			"limits": schema.SingleNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Resource limits of the environment.",
+				Attributes: map[string]schema.Attribute{
+					"clusters": schema.Int64Attribute{
+						Computed:            true,
+						MarkdownDescription: "Maximum number of ClickHouse clusters.",
+					},
+					"shards": schema.Int64Attribute{
+						Computed:            true,
+						MarkdownDescription: "Maximum number of shards per cluster.",
+					},
+					"replicas": schema.Int64Attribute{
+						Computed:            true,
+						MarkdownDescription: "Maximum number of replicas per shard.",
+					},
+					"nodes": schema.Int64Attribute{
+						Computed:            true,
+						MarkdownDescription: "Maximum number of ClickHouse nodes.",
+					},
+					"disk_size": schema.Int64Attribute{
+						Computed:            true,
+						MarkdownDescription: "Maximum data volume size per node in GB.",
+					},
+				},
+			},
+		},
+	}
+}
+
+// ConfigValidators - requires exactly one of id or name.
+func (d *environmentDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+// Configure - bootstraps environment datasource with Altinity.Cloud client.
+func (d *environmentDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring environment data source")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.AltinityCloudClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *altinityCloudClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read - looks up the environment by ID or name.
+func (d *environmentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading environment data source")
+	var state EnvironmentDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var env client.Environment
+	var err error
+	lookup := path.Root("name")
+	if !state.ID.IsNull() {
+		lookup = path.Root("id")
+		env, err = d.client.GetEnvironment(ctx, state.ID.ValueString())
+	} else {
+		env, err = d.client.GetEnvironmentByName(ctx, state.Name.ValueString())
+	}
+	if client.IsNotFound(err) {
+		resp.Diagnostics.AddAttributeError(
+			lookup,
+			"Environment Not Found",
+			fmt.Sprintf("Could not find Altinity.Cloud environment: %s", err),
+		)
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Client Error", "Unable to read environment", err, nil)
+		return
+	}
+
+	state = mapEnvironmentToDataSourceModel(env)
+
+	tflog.Trace(ctx, fmt.Sprintf("read environment %s (%s)", env.Name, env.ID))
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// mapEnvironmentToDataSourceModel - converts the API environment into the data source model.
+func mapEnvironmentToDataSourceModel(env client.Environment) EnvironmentDataSourceModel {
+	return EnvironmentDataSourceModel{
+		ID:                        types.StringValue(env.ID),
+		Name:                      types.StringValue(env.Name),
+		Cloud:                     types.StringValue(env.Cloud),
+		Region:                    types.StringValue(env.Region),
+		Zones:                     mapStringList(env.Zones),
+		DefaultClickHouseNodeType: types.StringValue(env.DefaultClickHouseNodeType),
+		DefaultZookeeperNodeType:  types.StringValue(env.DefaultZookeeperNodeType),
+		KubernetesVersion:         types.StringValue(env.KubernetesVersion),
+		KubernetesNamespace:       types.StringValue(env.KubernetesNamespace),
+		Limits: &EnvironmentLimitsModel{
+			Clusters: types.Int64Value(env.Limits.Clusters),
+			Shards:   types.Int64Value(env.Limits.Shards),
+			Replicas: types.Int64Value(env.Limits.Replicas),
+			Nodes:    types.Int64Value(env.Limits.Nodes),
+			DiskSize: types.Int64Value(env.Limits.DiskSize),
+		},
+	}
+}
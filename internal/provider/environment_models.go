@@ -0,0 +1,40 @@
+package provider
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// EnvironmentDataSourceModel - describes the environment model for data sources.
+type EnvironmentDataSourceModel struct {
+	ID                        types.String            `tfsdk:"id"`
+	Name                      types.String            `tfsdk:"name"`
+	Cloud                     types.String            `tfsdk:"cloud"`
+	Region                    types.String            `tfsdk:"region"`
+	Zones                     types.List              `tfsdk:"zones"`
+	DefaultClickHouseNodeType types.String            `tfsdk:"default_clickhouse_node_type"`
+	DefaultZookeeperNodeType  types.String            `tfsdk:"default_zookeeper_node_type"`
+	KubernetesVersion         types.String            `tfsdk:"kubernetes_version"`
+	KubernetesNamespace       types.String            `tfsdk:"kubernetes_namespace"`
+	Limits                    *EnvironmentLimitsModel `tfsdk:"limits"`
+}
+
+// EnvironmentLimitsModel - describes the environment resource limits.
+type EnvironmentLimitsModel struct {
+	Clusters types.Int64 `tfsdk:"clusters"`
+	Shards   types.Int64 `tfsdk:"shards"`
+	Replicas types.Int64 `tfsdk:"replicas"`
+	Nodes    types.Int64 `tfsdk:"nodes"`
+	DiskSize types.Int64 `tfsdk:"disk_size"`
+}
+
+// EnvironmentResourceModel - describes the environment model for resources.
+type EnvironmentResourceModel struct {
+	ID                        types.String `tfsdk:"id"`
+	Name                      types.String `tfsdk:"name"`
+	Cloud                     types.String `tfsdk:"cloud"`
+	Region                    types.String `tfsdk:"region"`
+	Zones                     types.List   `tfsdk:"zones"`
+	DefaultClickHouseNodeType types.String `tfsdk:"default_clickhouse_node_type"`
+	DefaultZookeeperNodeType  types.String `tfsdk:"default_zookeeper_node_type"`
+	KubernetesVersion         types.String `tfsdk:"kubernetes_version"`
+	KubernetesNamespace       types.String `tfsdk:"kubernetes_namespace"`
+	LastUpdated               types.String `tfsdk:"last_updated"`
+}
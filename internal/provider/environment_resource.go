@@ -0,0 +1,313 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/tatari-tv/terraform-provider-altinitycloud/cmd/client"
+	"time"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &environmentResource{}
+	_ resource.ResourceWithConfigure   = &environmentResource{}
+	_ resource.ResourceWithImportState = &environmentResource{}
+)
+
+// NewEnvironmentResource is a helper function to simplify the provider implementation.
+func NewEnvironmentResource() resource.Resource {
+	return &environmentResource{}
+}
+
+// environmentResource is the resource implementation.
+type environmentResource struct {
+	client *client.AltinityCloudClient
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *environmentResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.AltinityCloudClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Environment Source Configure Type",
+			fmt.Sprintf("Expected *client.AltinityCloudClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Metadata - returns the resource type name.
+func (r *environmentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_environment"
+}
+
+// Schema - defines the schema for the resource.
+func (r *environmentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Creates and configures an Altinity.Cloud environment.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Altinity.Cloud environment ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Altinity.Cloud environment name.",
+			},
+			"cloud": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Cloud provider of the environment (`aws`, `gcp` or `azure`).",
+				Validators: []validator.String{
+					stringvalidator.OneOf(environmentClouds...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"region": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Cloud provider region of the environment (e.g. `us-east-1`).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"zones": schema.ListAttribute{
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Availability zones used by the environment. Defaults to the zones chosen by Altinity.Cloud.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"default_clickhouse_node_type": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Default node type of ClickHouse nodes.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"default_zookeeper_node_type": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Default node type of ZooKeeper nodes.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"kubernetes_version": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Kubernetes version of the environment. This is auto-generated by the provider.",
+			},
+			"kubernetes_namespace": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Kubernetes namespace the environment runs in. This is auto-generated by the provider.",
+			},
+			"last_updated": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Altinity.Cloud environment last updated timestamp. This is auto-generated by the provider.",
+			},
+		},
+	}
+}
+
+// Create - creates the environment and sets the initial Terraform state.
+func (r *environmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating environment resource")
+	// Retrieve values from plan
+	var plan EnvironmentResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Failed to retrieve plan for Altinity.Cloud environment resource")
+		return
+	}
+
+	reqData, diags := mapEnvironmentModelToEnvironment(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create new environment
+	tflog.Info(ctx, fmt.Sprintf("Creating environment %s in %s %s", plan.Name.ValueString(), plan.Cloud.ValueString(), plan.Region.ValueString()))
+	env, err := r.client.CreateEnvironment(ctx, reqData)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error creating environment", "Could not create environment", err, environmentFieldPaths)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	mapEnvironmentToEnvironmentModel(env, &plan)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	// Set plan to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *environmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Read Altinity.Cloud environment resource")
+	// Get current state
+	var state EnvironmentResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed environment from Altinity.Cloud
+	env, err := r.client.GetEnvironment(ctx, state.ID.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, fmt.Sprintf("environment %s not found, removing it from state", state.ID.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error retrieving environment", "Could not retrieve environment", err, nil)
+		return
+	}
+
+	// Overwrite current state with refreshed data
+	mapEnvironmentToEnvironmentModel(env, &state)
+
+	tflog.Trace(ctx, fmt.Sprintf("refreshed environment %s from API", state.ID.ValueString()))
+
+	// set refreshed state
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update - updates the environment and sets the updated Terraform state on success.
+func (r *environmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Update environment resource")
+	// Retrieve values from plan
+	var plan EnvironmentResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Failed to retrieve plan for Altinity.Cloud environment resource")
+		return
+	}
+
+	reqData, diags := mapEnvironmentModelToEnvironment(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update environment in Altinity.Cloud
+	tflog.Info(ctx, fmt.Sprintf("Updating environment %s", plan.ID.ValueString()))
+	env, err := r.client.UpdateEnvironment(ctx, reqData)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error updating environment", "Could not update environment", err, environmentFieldPaths)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	mapEnvironmentToEnvironmentModel(env, &plan)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	// Set plan to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete - deletes the environment and removes the Terraform state on success.
+func (r *environmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Delete environment resource")
+	// Retrieve values from state
+	var state EnvironmentResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing environment, it may already be gone
+	err := r.client.DeleteEnvironment(ctx, state.ID.ValueString())
+	if client.IsNotFound(err) {
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error deleting environment", "Could not delete environment", err, nil)
+		return
+	}
+}
+
+// ImportState - imports an existing environment by its Altinity.Cloud ID.
+func (r *environmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, "Import environment resource")
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// mapEnvironmentModelToEnvironment - converts the Terraform model into an API request.
+func mapEnvironmentModelToEnvironment(ctx context.Context, m EnvironmentResourceModel) (client.Environment, diag.Diagnostics) {
+	env := client.Environment{
+		ID:                        m.ID.ValueString(),
+		Name:                      m.Name.ValueString(),
+		Cloud:                     m.Cloud.ValueString(),
+		Region:                    m.Region.ValueString(),
+		DefaultClickHouseNodeType: m.DefaultClickHouseNodeType.ValueString(),
+		DefaultZookeeperNodeType:  m.DefaultZookeeperNodeType.ValueString(),
+	}
+
+	var diags diag.Diagnostics
+	if !m.Zones.IsNull() && !m.Zones.IsUnknown() {
+		diags.Append(m.Zones.ElementsAs(ctx, &env.Zones, false)...)
+	}
+
+	return env, diags
+}
+
+// mapEnvironmentToEnvironmentModel - copies the API response into the Terraform model.
+func mapEnvironmentToEnvironmentModel(env client.Environment, m *EnvironmentResourceModel) {
+	m.ID = types.StringValue(env.ID)
+	m.Name = types.StringValue(env.Name)
+	m.Cloud = types.StringValue(env.Cloud)
+	m.Region = types.StringValue(env.Region)
+	m.Zones = mapStringList(env.Zones)
+	m.DefaultClickHouseNodeType = types.StringValue(env.DefaultClickHouseNodeType)
+	m.DefaultZookeeperNodeType = types.StringValue(env.DefaultZookeeperNodeType)
+	m.KubernetesVersion = types.StringValue(env.KubernetesVersion)
+	m.KubernetesNamespace = types.StringValue(env.KubernetesNamespace)
+}
@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/tatari-tv/terraform-provider-altinitycloud/cmd/client"
+	"github.com/tatari-tv/terraform-provider-altinitycloud/internal/fakeacm"
+	"testing"
+)
+
+func TestMapEnvironmentToEnvironmentModel(t *testing.T) {
+	ctx := context.Background()
+	env := client.Environment{
+		ID:                        "648",
+		Name:                      "tatari-prod",
+		Cloud:                     "aws",
+		Region:                    "us-east-1",
+		Zones:                     []string{"us-east-1a", "us-east-1b"},
+		DefaultClickHouseNodeType: "m6i.xlarge",
+		DefaultZookeeperNodeType:  "t3.large",
+		KubernetesVersion:         "1.29",
+		KubernetesNamespace:       "altinity-cloud-managed-clickhouse",
+	}
+
+	var m EnvironmentResourceModel
+	mapEnvironmentToEnvironmentModel(env, &m)
+	assert.Equal(t, env.ID, m.ID.ValueString())
+	assert.Equal(t, env.Cloud, m.Cloud.ValueString())
+	assert.Len(t, m.Zones.Elements(), 2)
+	assert.Equal(t, env.KubernetesVersion, m.KubernetesVersion.ValueString())
+
+	req, diags := mapEnvironmentModelToEnvironment(ctx, m)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, env.Zones, req.Zones)
+	assert.Equal(t, env.DefaultClickHouseNodeType, req.DefaultClickHouseNodeType)
+
+	// unknown zones are left for Altinity.Cloud to choose
+	m.Zones = types.ListUnknown(types.StringType)
+	req, diags = mapEnvironmentModelToEnvironment(ctx, m)
+	assert.False(t, diags.HasError())
+	assert.Empty(t, req.Zones)
+}
+
+func TestMapEnvironmentToDataSourceModel(t *testing.T) {
+	env := client.Environment{
+		ID:     "648",
+		Name:   "tatari-prod",
+		Limits: client.EnvironmentLimits{Clusters: 10, Shards: 8, Replicas: 3, Nodes: 48, DiskSize: 10000},
+	}
+
+	m := mapEnvironmentToDataSourceModel(env)
+	assert.Equal(t, "648", m.ID.ValueString())
+	assert.False(t, m.Zones.IsNull())
+	assert.Empty(t, m.Zones.Elements())
+	assert.Equal(t, int64(10), m.Limits.Clusters.ValueInt64())
+	assert.Equal(t, int64(10000), m.Limits.DiskSize.ValueInt64())
+}
+
+func TestAccEnvironmentResource(t *testing.T) {
+	s := fakeacm.NewServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing, the data source resolves the environment by name
+			{
+				Config: testAccProviderConfig(s) + testAccEnvironmentResourceConfig("m6i.xlarge"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("altinitycloud_environment.test", "id"),
+					resource.TestCheckResourceAttr("altinitycloud_environment.test", "zones.#", "2"),
+					resource.TestCheckResourceAttrPair("data.altinitycloud_environment.test", "id", "altinitycloud_environment.test", "id"),
+					resource.TestCheckResourceAttr("data.altinitycloud_environment.test", "limits.clusters", "10"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "altinitycloud_environment.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			// Update default node type in place
+			{
+				Config: testAccProviderConfig(s) + testAccEnvironmentResourceConfig("m6i.2xlarge"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("altinitycloud_environment.test", "default_clickhouse_node_type", "m6i.2xlarge"),
+				),
+			},
+		},
+	})
+}
+
+func testAccEnvironmentResourceConfig(nodeType string) string {
+	return fmt.Sprintf(`
+resource "altinitycloud_environment" "test" {
+  name                         = "tf-acc"
+  cloud                        = "aws"
+  region                       = "us-east-1"
+  zones                        = ["us-east-1a", "us-east-1b"]
+  default_clickhouse_node_type = %q
+}
+
+data "altinitycloud_environment" "test" {
+  name = altinitycloud_environment.test.name
+}
+`, nodeType)
+}
@@ -0,0 +1,22 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+)
+
+// splitImportID - splits a composite import ID into exactly n non-empty parts separated by "/".
+func splitImportID(id string, n int, format string) ([]string, error) {
+	parts := strings.SplitN(id, "/", n)
+	if len(parts) != n {
+		return nil, fmt.Errorf("expected import identifier with format %s, got: %q", format, id)
+	}
+
+	for _, p := range parts {
+		if p == "" {
+			return nil, fmt.Errorf("expected import identifier with format %s, got: %q", format, id)
+		}
+	}
+
+	return parts, nil
+}
@@ -3,8 +3,10 @@ package provider
 import (
 	"context"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/tatari-tv/terraform-provider-altinitycloud/cmd/client"
@@ -16,7 +18,7 @@ var (
 	_ datasource.DataSourceWithConfigure = &nodeTypeDataSource{}
 )
 
-func NewNodeTypesDataSource() datasource.DataSource {
+func NewNodeTypeDataSource() datasource.DataSource {
 	return &nodeTypeDataSource{}
 }
 
@@ -64,19 +66,23 @@ func (d *nodeTypeDataSource) Schema(ctx context.Context, req datasource.SchemaRe
 			},
 			"memory": schema.StringAttribute{
 				Optional:            true,
+				CustomType:          memoryQuantityType,
 				MarkdownDescription: "Kubernetes node memory size in MB.",
 			},
 			"cpu": schema.StringAttribute{
 				Optional:            true,
+				CustomType:          cpuQuantityType,
 				MarkdownDescription: "Kubernetes node CPU size in cores.",
 			},
 			"extra_spec": schema.StringAttribute{
 				Optional:            true,
-				MarkdownDescription: "Extra specification for the node type in string JSON format as a string.",
+				CustomType:          jsontypes.NormalizedType{},
+				MarkdownDescription: "Extra specification for the node type as a JSON document.",
 			},
-			"node_selector": schema.StringAttribute{
+			"node_selector": schema.MapAttribute{
 				Optional:            true,
-				MarkdownDescription: "Kubernetes node selector in string JSON format as a string.",
+				ElementType:         types.StringType,
+				MarkdownDescription: "Kubernetes node selector labels.",
 			},
 			"tolerations": schema.ListNestedAttribute{
 				Optional:            true,
@@ -106,6 +112,14 @@ func (d *nodeTypeDataSource) Schema(ctx context.Context, req datasource.SchemaRe
 				Computed:            true,
 				MarkdownDescription: "Kubernetes node memory allocation in MB. This is auto-generated by the provider.",
 			},
+			"cpu_alloc_cores": schema.Float64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Kubernetes node CPU allocation in cores as a number.",
+			},
+			"memory_alloc_mb": schema.Float64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Kubernetes node memory allocation in MB as a number.",
+			},
 		},
 	}
 }
@@ -143,35 +157,22 @@ func (d *nodeTypeDataSource) Read(ctx context.Context, req datasource.ReadReques
 	}
 
 	// initialize provider client state and make a call using it.
-	nodeType, err := d.client.GetNodeType(state.EnvID.ValueString(), state.Name.ValueString())
+	nodeType, err := d.client.GetNodeType(ctx, state.EnvID.ValueString(), state.Name.ValueString())
+	if client.IsNotFound(err) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Node Type Not Found",
+			fmt.Sprintf("Node type %s does not exist in environment %s.", state.Name.ValueString(), state.EnvID.ValueString()),
+		)
+		return
+	}
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read example, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Client Error", "Unable to read node type", err, nil)
 		return
 	}
 
 	// Create a new state object using the response.
-	updateNodeType := NodeTypeModel{
-		ID:           types.StringValue(nodeType.ID),
-		Scope:        types.StringValue(nodeType.Scope),
-		Code:         types.StringValue(nodeType.Code),
-		Name:         types.StringValue(nodeType.Name),
-		Pool:         types.StringValue(nodeType.Pool),
-		StorageClass: types.StringValue(nodeType.StorageClass),
-		CPU:          types.StringValue(nodeType.CPU),
-		Memory:       types.StringValue(nodeType.Memory),
-		ExtraSpec:    types.StringValue(nodeType.ExtraSpec),
-		NodeSelector: types.StringValue(nodeType.NodeSelector),
-		CPUAlloc:     types.StringValue(nodeType.CPUAlloc),
-		MemoryAlloc:  types.StringValue(nodeType.MemoryAlloc),
-	}
-	for _, t := range nodeType.Tolerations {
-		updateNodeType.Tolerations = append(updateNodeType.Tolerations, TolerationModel{
-			Key:      types.StringValue(t.Key),
-			Operator: types.StringValue(t.Operator),
-			Effect:   types.StringValue(t.Effect),
-			Value:    types.StringValue(t.Value),
-		})
-	}
+	updateNodeType := mapNodeTypeToNodeTypeResponse(nodeType)
 
 	state.ID = updateNodeType.ID
 	state.Name = updateNodeType.Name
@@ -185,6 +186,8 @@ func (d *nodeTypeDataSource) Read(ctx context.Context, req datasource.ReadReques
 	state.NodeSelector = updateNodeType.NodeSelector
 	state.CPUAlloc = updateNodeType.CPUAlloc
 	state.MemoryAlloc = updateNodeType.MemoryAlloc
+	state.CPUAllocCores = updateNodeType.CPUAllocCores
+	state.MemoryAllocMB = updateNodeType.MemoryAllocMB
 	state.Tolerations = updateNodeType.Tolerations
 
 	tflog.Trace(ctx, fmt.Sprintf("fetch node types from Altinity.Cloud API in environment %v", state.EnvID))
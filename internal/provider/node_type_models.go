@@ -1,24 +1,41 @@
 package provider
 
-import "github.com/hashicorp/terraform-plugin-framework/types"
+import (
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
 
 // NodeTypeDataSourceModel describes the NodeTypes source NodeTypes model for data sources.
 // @note: these attributes are at the same level because of this issue: https://github.com/hashicorp/terraform-plugin-framework/issues/191
 type NodeTypeDataSourceModel struct {
-	EnvID        types.String      `tfsdk:"env_id"`
-	ID           types.String      `tfsdk:"id"`
-	Name         types.String      `tfsdk:"name"`
-	Scope        types.String      `tfsdk:"scope"`
-	Code         types.String      `tfsdk:"code"`
-	Pool         types.String      `tfsdk:"pool"`
-	StorageClass types.String      `tfsdk:"storage_class"`
-	CPU          types.String      `tfsdk:"cpu"`
-	Memory       types.String      `tfsdk:"memory"`
-	ExtraSpec    types.String      `tfsdk:"extra_spec"`
-	Tolerations  []TolerationModel `tfsdk:"tolerations"`
-	NodeSelector types.String      `tfsdk:"node_selector"`
-	CPUAlloc     types.String      `tfsdk:"cpu_alloc"`
-	MemoryAlloc  types.String      `tfsdk:"memory_alloc"`
+	EnvID         types.String         `tfsdk:"env_id"`
+	ID            types.String         `tfsdk:"id"`
+	Name          types.String         `tfsdk:"name"`
+	Scope         types.String         `tfsdk:"scope"`
+	Code          types.String         `tfsdk:"code"`
+	Pool          types.String         `tfsdk:"pool"`
+	StorageClass  types.String         `tfsdk:"storage_class"`
+	CPU           quantityValue        `tfsdk:"cpu"`
+	Memory        quantityValue        `tfsdk:"memory"`
+	ExtraSpec     jsontypes.Normalized `tfsdk:"extra_spec"`
+	Tolerations   []TolerationModel    `tfsdk:"tolerations"`
+	NodeSelector  types.Map            `tfsdk:"node_selector"`
+	CPUAlloc      types.String         `tfsdk:"cpu_alloc"`
+	MemoryAlloc   types.String         `tfsdk:"memory_alloc"`
+	CPUAllocCores types.Float64        `tfsdk:"cpu_alloc_cores"`
+	MemoryAllocMB types.Float64        `tfsdk:"memory_alloc_mb"`
+}
+
+// NodeTypesDataSourceModel - describes the filters and node types of the node types data source.
+type NodeTypesDataSourceModel struct {
+	EnvID        types.String    `tfsdk:"env_id"`
+	Scope        types.String    `tfsdk:"scope"`
+	StorageClass types.String    `tfsdk:"storage_class"`
+	Pool         types.String    `tfsdk:"pool"`
+	NameRegex    types.String    `tfsdk:"name_regex"`
+	MinCPU       types.Float64   `tfsdk:"min_cpu"`
+	MinMemory    types.Float64   `tfsdk:"min_memory"`
+	NodeTypes    []NodeTypeModel `tfsdk:"node_types"`
 }
 
 // NodeTypeResourceModel - describes the NodeTypes source NodeTypes model for resources.
@@ -30,19 +47,21 @@ type NodeTypeResourceModel struct {
 
 // NodeTypeModel - node type datasource representation.
 type NodeTypeModel struct {
-	ID           types.String      `tfsdk:"id"`
-	Name         types.String      `tfsdk:"name"`
-	Scope        types.String      `tfsdk:"scope"`
-	Code         types.String      `tfsdk:"code"`
-	Pool         types.String      `tfsdk:"pool"`
-	StorageClass types.String      `tfsdk:"storage_class"`
-	CPU          types.String      `tfsdk:"cpu"`
-	Memory       types.String      `tfsdk:"memory"`
-	ExtraSpec    types.String      `tfsdk:"extra_spec"`
-	NodeSelector types.String      `tfsdk:"node_selector"`
-	Tolerations  []TolerationModel `tfsdk:"tolerations"`
-	CPUAlloc     types.String      `tfsdk:"cpu_alloc"`
-	MemoryAlloc  types.String      `tfsdk:"memory_alloc"`
+	ID            types.String         `tfsdk:"id"`
+	Name          types.String         `tfsdk:"name"`
+	Scope         types.String         `tfsdk:"scope"`
+	Code          types.String         `tfsdk:"code"`
+	Pool          types.String         `tfsdk:"pool"`
+	StorageClass  types.String         `tfsdk:"storage_class"`
+	CPU           quantityValue        `tfsdk:"cpu"`
+	Memory        quantityValue        `tfsdk:"memory"`
+	ExtraSpec     jsontypes.Normalized `tfsdk:"extra_spec"`
+	NodeSelector  types.Map            `tfsdk:"node_selector"`
+	Tolerations   []TolerationModel    `tfsdk:"tolerations"`
+	CPUAlloc      types.String         `tfsdk:"cpu_alloc"`
+	MemoryAlloc   types.String         `tfsdk:"memory_alloc"`
+	CPUAllocCores types.Float64        `tfsdk:"cpu_alloc_cores"`
+	MemoryAllocMB types.Float64        `tfsdk:"memory_alloc_mb"`
 }
 
 // TolerationModel - Kubernetes tolerations.
@@ -4,13 +4,20 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/tatari-tv/terraform-provider-altinitycloud/cmd/client"
+	"slices"
 	"time"
 )
 
@@ -19,6 +26,7 @@ var (
 	_ resource.Resource                = &nodeTypeResource{}
 	_ resource.ResourceWithConfigure   = &nodeTypeResource{}
 	_ resource.ResourceWithImportState = &nodeTypeResource{}
+	_ resource.ResourceWithModifyPlan  = &nodeTypeResource{}
 )
 
 // NewNodeTypeResource is a helper function to simplify the provider implementation.
@@ -70,6 +78,9 @@ func (r *nodeTypeResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 					"id": schema.StringAttribute{
 						Computed:            true,
 						MarkdownDescription: "Altinity.Cloud node type ID.",
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
 					},
 					"name": schema.StringAttribute{
 						Required:            true,
@@ -78,6 +89,9 @@ func (r *nodeTypeResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 					"scope": schema.StringAttribute{
 						Required:            true,
 						MarkdownDescription: "Kubernetes node type scope (either `ClickHouse` or `Zookeeper`).",
+						Validators: []validator.String{
+							stringvalidator.OneOf(nodeTypeScopes...),
+						},
 					},
 					"code": schema.StringAttribute{
 						Required:            true,
@@ -90,24 +104,39 @@ func (r *nodeTypeResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 					"storage_class": schema.StringAttribute{
 						Required:            true,
 						MarkdownDescription: "Kubernetes disk storage class type (either `gp2 or `gp3`).",
+						Validators:          storageClassValidators(),
 					},
 					"memory": schema.StringAttribute{
 						Required:            true,
-						MarkdownDescription: "Kubernetes node memory size in MB.",
+						CustomType:          memoryQuantityType,
+						MarkdownDescription: "Kubernetes node memory size in MB, or a Kubernetes quantity such as `16Gi`.",
+						Validators: []validator.String{
+							memoryQuantity(),
+						},
+						PlanModifiers: []planmodifier.String{
+							quantityPlanModifier{memory: true},
+						},
 					},
 					"cpu": schema.StringAttribute{
 						Required:            true,
-						MarkdownDescription: "Kubernetes node CPU size in cores.",
+						CustomType:          cpuQuantityType,
+						MarkdownDescription: "Kubernetes node CPU size in cores, or a Kubernetes quantity such as `500m`.",
+						Validators: []validator.String{
+							cpuQuantity(),
+						},
+						PlanModifiers: []planmodifier.String{
+							quantityPlanModifier{},
+						},
 					},
 					"extra_spec": schema.StringAttribute{
-						Computed:            true,
-						MarkdownDescription: "Extra specification for the node type in string JSON format as a string.",
-						Default:             stringdefault.StaticString(""),
+						Optional:            true,
+						CustomType:          jsontypes.NormalizedType{},
+						MarkdownDescription: "Extra specification for the node type as a JSON document, e.g. `jsonencode({...})`. Formatting and key order differences are ignored.",
 					},
-					"node_selector": schema.StringAttribute{
-						Computed:            true,
-						MarkdownDescription: "Kubernetes node selector in string JSON format as a string.",
-						Default:             stringdefault.StaticString(""),
+					"node_selector": schema.MapAttribute{
+						Optional:            true,
+						ElementType:         types.StringType,
+						MarkdownDescription: "Kubernetes node selector labels.",
 					},
 					"tolerations": schema.ListNestedAttribute{
 						Optional:            true,
@@ -118,10 +147,18 @@ func (r *nodeTypeResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 									Optional: true,
 								},
 								"operator": schema.StringAttribute{
-									Optional: true,
+									Optional:            true,
+									MarkdownDescription: "Toleration operator (either `Equal` or `Exists`).",
+									Validators: []validator.String{
+										stringvalidator.OneOf(tolerationOperators...),
+									},
 								},
 								"effect": schema.StringAttribute{
-									Optional: true,
+									Optional:            true,
+									MarkdownDescription: "Taint effect to tolerate (`NoSchedule`, `PreferNoSchedule` or `NoExecute`).",
+									Validators: []validator.String{
+										stringvalidator.OneOf(tolerationEffects...),
+									},
 								},
 								"value": schema.StringAttribute{
 									Optional: true,
@@ -137,6 +174,14 @@ func (r *nodeTypeResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 						Computed:            true,
 						MarkdownDescription: "Kubernetes node memory allocation in MB. This is auto-generated by the provider.",
 					},
+					"cpu_alloc_cores": schema.Float64Attribute{
+						Computed:            true,
+						MarkdownDescription: "Kubernetes node CPU allocation in cores as a number. This is auto-generated by the provider.",
+					},
+					"memory_alloc_mb": schema.Float64Attribute{
+						Computed:            true,
+						MarkdownDescription: "Kubernetes node memory allocation in MB as a number. This is auto-generated by the provider.",
+					},
 				},
 			},
 			"last_updated": schema.StringAttribute{
@@ -161,27 +206,10 @@ func (r *nodeTypeResource) Create(ctx context.Context, req resource.CreateReques
 
 	// Generate API request body from plan
 	tflog.Info(ctx, "Generating API create request params from the plan")
-	reqData := client.NodeType{
-		ID:           plan.NodeType.ID.ValueString(),
-		Name:         plan.NodeType.Name.ValueString(),
-		Scope:        plan.NodeType.Scope.ValueString(),
-		Code:         plan.NodeType.Code.ValueString(),
-		Pool:         plan.NodeType.Pool.ValueString(),
-		StorageClass: plan.NodeType.StorageClass.ValueString(),
-		CPU:          plan.NodeType.CPU.ValueString(),
-		Memory:       plan.NodeType.Memory.ValueString(),
-		ExtraSpec:    plan.NodeType.ExtraSpec.ValueString(),
-		NodeSelector: plan.NodeType.NodeSelector.ValueString(),
-		Tolerations:  nil,
-	}
-	// Convert tolerations from schema to API format
-	for _, nt := range plan.NodeType.Tolerations {
-		reqData.Tolerations = append(reqData.Tolerations, client.Toleration{
-			Key:      nt.Key.ValueString(),
-			Operator: nt.Operator.ValueString(),
-			Effect:   nt.Effect.ValueString(),
-			Value:    nt.Value.ValueString(),
-		})
+	reqData, diags := mapNodeTypeModelToNodeType(ctx, plan.NodeType)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	// Create new Node Type
@@ -192,12 +220,9 @@ func (r *nodeTypeResource) Create(ctx context.Context, req resource.CreateReques
 	}
 	// Create new Node Type
 	tflog.Info(ctx, "Creating new node type via API "+string(data))
-	nodeType, err := r.client.CreateNodeType(plan.EnvID.ValueString(), reqData)
+	nodeType, err := r.client.CreateNodeType(ctx, plan.EnvID.ValueString(), reqData)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating node type",
-			"Could not create node types, unexpected error: "+err.Error(),
-		)
+		addClientError(&resp.Diagnostics, "Error creating node type", "Could not create node type", err, nodeTypeFieldPaths)
 		return
 	}
 
@@ -227,44 +252,29 @@ func (r *nodeTypeResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
-	// Get refreshed node types from Altinity.Cloud
-	tflog.Trace(ctx, fmt.Sprintf("env id %v node type name %v", plan.EnvID.String(), plan.NodeType.Name.String()))
-	nodeType, err := r.client.GetNodeType(plan.EnvID.ValueString(), plan.NodeType.Name.ValueString())
+	// Get refreshed node type from Altinity.Cloud by its ID
+	tflog.Trace(ctx, fmt.Sprintf("env id %v node type id %v name %v", plan.EnvID.String(), plan.NodeType.ID.String(), plan.NodeType.Name.String()))
+	var nodeType client.NodeType
+	var err error
+	if id := plan.NodeType.ID.ValueString(); id != "" {
+		nodeType, err = r.client.GetNodeTypeByID(ctx, id)
+	} else {
+		// state without an ID can only be looked up by name
+		nodeType, err = r.client.GetNodeType(ctx, plan.EnvID.ValueString(), plan.NodeType.Name.ValueString())
+	}
 	tflog.Trace(ctx, fmt.Sprintf("got node type from API %v", nodeType))
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, fmt.Sprintf("node type %s not found, removing it from state", plan.NodeType.Name.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error retrieving node types",
-			"Could not retrieve node types, unexpected error: "+err.Error(),
-		)
+		addClientError(&resp.Diagnostics, "Error retrieving node types", "Could not retrieve node types", err, nil)
 		return
 	}
 
 	// Overwrite current plan with refreshed data
-	updatedState := NodeTypeModel{
-		ID:           types.StringValue(nodeType.ID),
-		Scope:        types.StringValue(nodeType.Scope),
-		Code:         types.StringValue(nodeType.Code),
-		Name:         types.StringValue(nodeType.Name),
-		Pool:         types.StringValue(nodeType.Pool),
-		StorageClass: types.StringValue(nodeType.StorageClass),
-		CPU:          types.StringValue(nodeType.CPU),
-		Memory:       types.StringValue(nodeType.Memory),
-		ExtraSpec:    types.StringValue(nodeType.ExtraSpec),
-		NodeSelector: types.StringValue(nodeType.NodeSelector),
-		CPUAlloc:     types.StringValue(nodeType.CPUAlloc),
-		MemoryAlloc:  types.StringValue(nodeType.MemoryAlloc),
-	}
-
-	for _, t := range nodeType.Tolerations {
-		updatedState.Tolerations = append(updatedState.Tolerations, TolerationModel{
-			Key:      types.StringValue(t.Key),
-			Operator: types.StringValue(t.Operator),
-			Effect:   types.StringValue(t.Effect),
-			Value:    types.StringValue(t.Value),
-		})
-	}
-	// update plan with node type
-	plan.NodeType = updatedState
+	plan.NodeType = mapNodeTypeToNodeTypeResponse(nodeType)
 
 	tflog.Trace(ctx, fmt.Sprintf("refreshed node types from API in environment %v", plan.EnvID))
 
@@ -288,38 +298,28 @@ func (r *nodeTypeResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	// The node type is updated by the ID stored in state, so it can be renamed in place
+	var state NodeTypeResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Generate API request body from plan
 	tflog.Info(ctx, "Generating API update request params from the plan")
-	reqData := client.NodeType{
-		Name:         plan.NodeType.Name.ValueString(),
-		Scope:        plan.NodeType.Scope.ValueString(),
-		Code:         plan.NodeType.Code.ValueString(),
-		Pool:         plan.NodeType.Pool.ValueString(),
-		StorageClass: plan.NodeType.StorageClass.ValueString(),
-		CPU:          plan.NodeType.CPU.ValueString(),
-		Memory:       plan.NodeType.Memory.ValueString(),
-		ExtraSpec:    plan.NodeType.ExtraSpec.ValueString(),
-		NodeSelector: plan.NodeType.NodeSelector.ValueString(),
-		Tolerations:  nil,
-	}
-	// Convert tolerations from schema to API format
-	for _, nt := range plan.NodeType.Tolerations {
-		reqData.Tolerations = append(reqData.Tolerations, client.Toleration{
-			Key:      nt.Key.ValueString(),
-			Operator: nt.Operator.ValueString(),
-			Effect:   nt.Effect.ValueString(),
-			Value:    nt.Value.ValueString(),
-		})
+	reqData, diags := mapNodeTypeModelToNodeType(ctx, plan.NodeType)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+	reqData.ID = state.NodeType.ID.ValueString()
 
 	// Update node type in Altinity.Cloud
-	tflog.Info(ctx, fmt.Sprintf("Updating node type %s in environment ID %s", plan.NodeType.Name.ValueString(), plan.EnvID.ValueString()))
-	nodeType, err := r.client.UpdateNodeType(plan.EnvID.ValueString(), reqData)
+	tflog.Info(ctx, fmt.Sprintf("Updating node type %s (ID %s) in environment ID %s", plan.NodeType.Name.ValueString(), reqData.ID, plan.EnvID.ValueString()))
+	nodeType, err := r.client.UpdateNodeType(ctx, reqData)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error updating node type",
-			"Could not update node type, unexpected error: "+err.Error(),
-		)
+		addClientError(&resp.Diagnostics, "Error updating node type", "Could not update node type", err, nodeTypeFieldPaths)
 		return
 	}
 
@@ -348,38 +348,175 @@ func (r *nodeTypeResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
-	// Delete existing order
-	err := r.client.DeleteNodeType(state.NodeType.ID.ValueString())
+	// Nothing to delete if the node type was never created
+	if state.NodeType.ID.ValueString() == "" {
+		tflog.Warn(ctx, fmt.Sprintf("node type %s has no ID, skipping delete", state.NodeType.Name.ValueString()))
+		return
+	}
+
+	// Delete existing node type, it may already be gone
+	err := r.client.DeleteNodeType(ctx, state.NodeType.ID.ValueString())
+	if client.IsNotFound(err) {
+		return
+	}
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Deleting HashiCups Order",
-			"Could not delete order, unexpected error: "+err.Error(),
-		)
+		addClientError(&resp.Diagnostics, "Error deleting node type", "Could not delete node type", err, nil)
+		return
+	}
+}
+
+// ModifyPlan - plans no changes when the configured node type only differs from the state
+// in how it is written, e.g. memory `16Gi` instead of `16384`, and sends the same request.
+func (r *nodeTypeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// nothing to compare on create and destroy, or while the config is not fully known
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() || !req.Config.Raw.IsFullyKnown() {
+		return
+	}
+
+	var plan, state NodeTypeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || !plan.EnvID.Equal(state.EnvID) {
 		return
 	}
+
+	// invalid values are reported by the attribute validators
+	planned, diags := mapNodeTypeModelToNodeType(ctx, plan.NodeType)
+	if diags.HasError() {
+		return
+	}
+	prior, diags := mapNodeTypeModelToNodeType(ctx, state.NodeType)
+	if diags.HasError() {
+		return
+	}
+
+	if sameNodeTypeRequest(planned, prior) {
+		tflog.Debug(ctx, fmt.Sprintf("node type %s is unchanged, keeping the prior state", prior.ID))
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, state)...)
+	}
 }
 
+// ImportState - imports a node type by "<env_id>/<node_type_name>" or "<env_id>/<node_type_id>".
+// The node type found in the environment is written to state and refreshed by Read.
 func (r *nodeTypeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	tflog.Info(ctx, "Import node type resource")
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	parts, err := splitImportID(req.ID, 2, "<env_id>/<node_type_name> or <env_id>/<node_type_id>")
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", err.Error())
+		return
+	}
+	envID, nameOrID := parts[0], parts[1]
+
+	// Look up the node type by ID first and fall back to name
+	nts, err := r.client.GetNodeTypes(ctx, envID)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error importing node type", "Could not retrieve node types", err, nil)
+		return
+	}
+
+	var found *client.NodeType
+	for i, nt := range nts.NodeTypes {
+		if nt.ID == nameOrID {
+			found = &nts.NodeTypes[i]
+			break
+		}
+		if found == nil && nt.Name == nameOrID {
+			found = &nts.NodeTypes[i]
+		}
+	}
+
+	if found == nil {
+		resp.Diagnostics.AddError(
+			"Error importing node type",
+			fmt.Sprintf("Node type %s does not exist in environment %s.", nameOrID, envID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("env_id"), envID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("node_type"), mapNodeTypeToNodeTypeResponse(*found))...)
+}
+
+// mapNodeTypeModelToNodeType - converts the Terraform node type into an API request.
+func mapNodeTypeModelToNodeType(ctx context.Context, m NodeTypeModel) (client.NodeType, diag.Diagnostics) {
+	nodeType := client.NodeType{
+		ID:           m.ID.ValueString(),
+		Name:         m.Name.ValueString(),
+		Scope:        m.Scope.ValueString(),
+		Code:         m.Code.ValueString(),
+		Pool:         m.Pool.ValueString(),
+		StorageClass: m.StorageClass.ValueString(),
+		ExtraSpec:    client.JSONString(m.ExtraSpec.ValueString()),
+		Tolerations:  nil,
+	}
+
+	// Convert cpu and memory to the API canonical units
+	var diags diag.Diagnostics
+	var err error
+	if nodeType.CPU, err = m.CPU.Normalized(); err != nil {
+		diags.AddAttributeError(path.Root("node_type").AtName("cpu"), "Invalid Quantity", err.Error())
+	}
+	if nodeType.Memory, err = m.Memory.Normalized(); err != nil {
+		diags.AddAttributeError(path.Root("node_type").AtName("memory"), "Invalid Quantity", err.Error())
+	}
+	if diags.HasError() {
+		return nodeType, diags
+	}
+
+	// Convert node selector from schema to API format
+	if !m.NodeSelector.IsNull() && !m.NodeSelector.IsUnknown() {
+		diags.Append(m.NodeSelector.ElementsAs(ctx, &nodeType.NodeSelector, false)...)
+		if diags.HasError() {
+			return nodeType, diags
+		}
+	}
+
+	// Convert tolerations from schema to API format
+	for _, t := range m.Tolerations {
+		nodeType.Tolerations = append(nodeType.Tolerations, client.Toleration{
+			Key:      t.Key.ValueString(),
+			Operator: t.Operator.ValueString(),
+			Effect:   t.Effect.ValueString(),
+			Value:    t.Value.ValueString(),
+		})
+	}
+
+	return nodeType, nil
+}
+
+// sameNodeTypeRequest - reports whether two node types send the same create or update request.
+// Only the configurable fields are compared, with cpu and memory in their normalized API units,
+// so fields set by the server never count as a change.
+func sameNodeTypeRequest(a, b client.NodeType) bool {
+	return a.Name == b.Name &&
+		a.Scope == b.Scope &&
+		a.Code == b.Code &&
+		a.Pool == b.Pool &&
+		a.StorageClass == b.StorageClass &&
+		a.CPU == b.CPU &&
+		a.Memory == b.Memory &&
+		a.ExtraSpec == b.ExtraSpec &&
+		a.NodeSelector.String() == b.NodeSelector.String() &&
+		slices.Equal(a.Tolerations, b.Tolerations)
 }
 
 func mapNodeTypeToNodeTypeResponse(nodeType client.NodeType) NodeTypeModel {
 	nodeTypeModel := NodeTypeModel{
 		// require parameters
-		ID:           types.StringValue(nodeType.ID),
-		Name:         types.StringValue(nodeType.Name),
-		Scope:        types.StringValue(nodeType.Scope),
-		Code:         types.StringValue(nodeType.Code),
-		Pool:         types.StringValue(nodeType.Pool),
-		StorageClass: types.StringValue(nodeType.StorageClass),
-		CPU:          types.StringValue(nodeType.CPU),
-		Memory:       types.StringValue(nodeType.Memory),
-		ExtraSpec:    types.StringValue(nodeType.ExtraSpec),
-		NodeSelector: types.StringValue(nodeType.NodeSelector),
-		CPUAlloc:     types.StringValue(nodeType.CPUAlloc),
-		MemoryAlloc:  types.StringValue(nodeType.MemoryAlloc),
+		ID:            types.StringValue(nodeType.ID),
+		Name:          types.StringValue(nodeType.Name),
+		Scope:         types.StringValue(nodeType.Scope),
+		Code:          types.StringValue(nodeType.Code),
+		Pool:          types.StringValue(nodeType.Pool),
+		StorageClass:  types.StringValue(nodeType.StorageClass),
+		CPU:           newCPUValue(nodeType.CPU),
+		Memory:        newMemoryValue(nodeType.Memory),
+		ExtraSpec:     mapExtraSpec(nodeType.ExtraSpec),
+		NodeSelector:  mapStringMap(nodeType.NodeSelector),
+		CPUAlloc:      types.StringValue(nodeType.CPUAlloc),
+		MemoryAlloc:   types.StringValue(nodeType.MemoryAlloc),
+		CPUAllocCores: mapQuantity(nodeType.CPUAlloc, client.ParseCPU),
+		MemoryAllocMB: mapQuantity(nodeType.MemoryAlloc, client.ParseMemory),
 	}
 
 	// set optional tolerations if they are not empty
@@ -395,3 +532,33 @@ func mapNodeTypeToNodeTypeResponse(nodeType client.NodeType) NodeTypeModel {
 	}
 	return nodeTypeModel
 }
+
+// mapStringMap - converts an API string map like the node selector into a Terraform map, empty maps are null.
+func mapStringMap(m map[string]string) types.Map {
+	if len(m) == 0 {
+		return types.MapNull(types.StringType)
+	}
+
+	elements := make(map[string]attr.Value, len(m))
+	for k, v := range m {
+		elements[k] = types.StringValue(v)
+	}
+	return types.MapValueMust(types.StringType, elements)
+}
+
+// mapExtraSpec - converts the API extra spec into normalized JSON, empty specs are null.
+func mapExtraSpec(js client.JSONString) jsontypes.Normalized {
+	if len(js) == 0 {
+		return jsontypes.NewNormalizedNull()
+	}
+	return jsontypes.NewNormalizedValue(string(js))
+}
+
+// mapQuantity - converts an API size into a number, empty or unparsable sizes are null.
+func mapQuantity(s string, parse func(string) (float64, error)) types.Float64 {
+	value, err := parse(s)
+	if err != nil {
+		return types.Float64Null()
+	}
+	return types.Float64Value(value)
+}
@@ -1,8 +1,20 @@
 package provider
 
 import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/stretchr/testify/assert"
 	"github.com/tatari-tv/terraform-provider-altinitycloud/cmd/client"
+	"github.com/tatari-tv/terraform-provider-altinitycloud/internal/fakeacm"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 )
 
@@ -57,6 +69,255 @@ func TestMapNodeTypeToNodeTypeResource(t *testing.T) {
 	assert.Equal(t, nrt2.CPU.ValueString(), nt1.CPU)
 	assert.Equal(t, nrt2.Memory.ValueString(), nt1.Memory)
 	assert.Equal(t, nrt2.Pool.ValueString(), nt1.Pool)
-	assert.Equal(t, nrt2.NodeSelector.ValueString(), "")
+	assert.True(t, nrt2.NodeSelector.IsNull())
+	assert.True(t, nrt2.ExtraSpec.IsNull())
 	assert.Equal(t, len(nrt2.Tolerations), 0)
 }
+
+func TestSameNodeTypeRequest(t *testing.T) {
+	nt := client.NodeType{
+		ID:           "1000",
+		Name:         "m6i.xlarge",
+		Scope:        "ClickHouse",
+		CPU:          "4",
+		Memory:       "16384",
+		NodeSelector: client.NodeSelector{"disktype": "ssd"},
+		Tolerations:  []client.Toleration{{Key: "dedicated", Operator: "Equal", Value: "clickhouse", Effect: "NoSchedule"}},
+	}
+
+	// server-set fields are not part of the request
+	other := nt
+	other.ID = ""
+	other.CPUAlloc = "3.5"
+	other.MemoryAlloc = "14000"
+	assert.True(t, sameNodeTypeRequest(nt, other))
+
+	other = nt
+	other.Memory = "32768"
+	assert.False(t, sameNodeTypeRequest(nt, other))
+
+	other = nt
+	other.NodeSelector = client.NodeSelector{"disktype": "hdd"}
+	assert.False(t, sameNodeTypeRequest(nt, other))
+
+	other = nt
+	other.Tolerations = nil
+	assert.False(t, sameNodeTypeRequest(nt, other))
+}
+
+// newNodeTypeState - builds node type resource state from the model.
+func newNodeTypeState(t *testing.T, r resource.Resource, m NodeTypeResourceModel) tfsdk.State {
+	ctx := context.Background()
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	diags := state.Set(ctx, m)
+	if diags.HasError() {
+		t.Fatalf("state.Set(), want no error got %v", diags)
+	}
+	return state
+}
+
+// newNodeTypeTestResource - node type resource backed by a test HTTP server.
+func newNodeTypeTestResource(t *testing.T, handler http.HandlerFunc) *nodeTypeResource {
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	endpoint := srv.URL
+	c, err := client.NewClient(&endpoint, nil)
+	if err != nil {
+		t.Fatalf(`NewClient(&endpoint, nil), want nil got %v`, err)
+	}
+	return &nodeTypeResource{client: c}
+}
+
+func TestNodeTypeResourceReadRemovesMissing(t *testing.T) {
+	r := newNodeTypeTestResource(t, func(w http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "/nodetype/1", req.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	state := newNodeTypeState(t, r, NodeTypeResourceModel{
+		EnvID:       types.StringValue("648"),
+		NodeType:    mapNodeTypeToNodeTypeResponse(client.NodeType{ID: "1", Name: "deleted"}),
+		LastUpdated: types.StringNull(),
+	})
+
+	resp := resource.ReadResponse{State: state}
+	r.Read(context.Background(), resource.ReadRequest{State: state}, &resp)
+	assert.False(t, resp.Diagnostics.HasError())
+	assert.True(t, resp.State.Raw.IsNull(), "node type deleted out-of-band should be removed from state")
+}
+
+func TestNodeTypeResourceImportState(t *testing.T) {
+	r := newNodeTypeTestResource(t, func(w http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "/environment/648/nodetypes", req.URL.Path)
+		_, _ = w.Write([]byte(`{"data":[{"id":"7","name":"r6a.xlarge","scope":"ClickHouse"},{"id":"8","name":"7"}]}`))
+	})
+
+	tests := []struct {
+		importID string
+		wantID   string
+	}{
+		{"648/r6a.xlarge", "7"},
+		{"648/7", "7"},
+		{"648/8", "8"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.importID, func(t *testing.T) {
+			ctx := context.Background()
+			empty := newNodeTypeState(t, r, NodeTypeResourceModel{NodeType: mapNodeTypeToNodeTypeResponse(client.NodeType{})})
+			empty.Raw = tftypes.NewValue(empty.Schema.Type().TerraformType(ctx), nil)
+
+			resp := resource.ImportStateResponse{State: empty}
+			r.ImportState(ctx, resource.ImportStateRequest{ID: tt.importID}, &resp)
+			assert.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+			var state NodeTypeResourceModel
+			resp.State.Get(ctx, &state)
+			assert.Equal(t, "648", state.EnvID.ValueString())
+			assert.Equal(t, tt.wantID, state.NodeType.ID.ValueString())
+		})
+	}
+}
+
+func TestNodeTypeResourceImportStateInvalidID(t *testing.T) {
+	r := &nodeTypeResource{}
+	for _, id := range []string{"r6a.xlarge", "648/", "/r6a.xlarge"} {
+		resp := resource.ImportStateResponse{}
+		r.ImportState(context.Background(), resource.ImportStateRequest{ID: id}, &resp)
+		assert.True(t, resp.Diagnostics.HasError(), "import ID %q should be rejected", id)
+	}
+}
+
+func TestNodeTypeResourceUpdateByID(t *testing.T) {
+	r := newNodeTypeTestResource(t, func(w http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "/nodetype/1", req.URL.Path)
+		assert.Equal(t, "renamed", req.URL.Query().Get("name"))
+		assert.Equal(t, "0.5", req.URL.Query().Get("cpu"))
+		assert.Equal(t, "1024", req.URL.Query().Get("memory"))
+		_, _ = w.Write([]byte(`{"data":{"id":"1","name":"renamed"}}`))
+	})
+
+	ctx := context.Background()
+	state := newNodeTypeState(t, r, NodeTypeResourceModel{
+		EnvID:       types.StringValue("648"),
+		NodeType:    mapNodeTypeToNodeTypeResponse(client.NodeType{ID: "1", Name: "original"}),
+		LastUpdated: types.StringNull(),
+	})
+	planned := mapNodeTypeToNodeTypeResponse(client.NodeType{Name: "renamed", CPU: "500m", Memory: "1Gi"})
+	planned.ID = types.StringUnknown()
+	plan := newNodeTypeState(t, r, NodeTypeResourceModel{
+		EnvID:       types.StringValue("648"),
+		NodeType:    planned,
+		LastUpdated: types.StringUnknown(),
+	})
+
+	resp := resource.UpdateResponse{State: state}
+	r.Update(ctx, resource.UpdateRequest{State: state, Plan: tfsdk.Plan(plan)}, &resp)
+	assert.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+	var updated NodeTypeResourceModel
+	resp.State.Get(ctx, &updated)
+	assert.Equal(t, "1", updated.NodeType.ID.ValueString())
+	assert.Equal(t, "renamed", updated.NodeType.Name.ValueString())
+}
+
+func TestNodeSelectorAndExtraSpecRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	nt := client.NodeType{
+		Name:         "test",
+		CPU:          "4",
+		Memory:       "16384",
+		NodeSelector: client.NodeSelector{"disktype": "ssd", "zone": "a"},
+		ExtraSpec:    client.JSONString(`{"labels": {"team": "data"}}`),
+	}
+
+	m := mapNodeTypeToNodeTypeResponse(nt)
+	assert.Len(t, m.NodeSelector.Elements(), 2)
+	equal, diags := m.ExtraSpec.StringSemanticEquals(ctx, jsontypes.NewNormalizedValue(`{"labels":{"team":"data"}}`))
+	assert.False(t, diags.HasError())
+	assert.True(t, equal, "extra spec formatting differences should be ignored")
+
+	req, diags := mapNodeTypeModelToNodeType(ctx, m)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, nt.NodeSelector, req.NodeSelector)
+	assert.Equal(t, nt.ExtraSpec, req.ExtraSpec)
+}
+
+func TestAccNodeTypeResource(t *testing.T) {
+	s := fakeacm.NewServer(t)
+	// the first refresh hits a transient error that is retried
+	s.Inject(fakeacm.Fault{Method: http.MethodGet, Path: "/nodetype/", Status: http.StatusServiceUnavailable, Times: 1})
+
+	tfresource.Test(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig(s) + testAccNodeTypeResourceConfig("tf_acc", "16Gi"),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttrSet("altinitycloud_node_type.test", "node_type.id"),
+					tfresource.TestCheckResourceAttr("altinitycloud_node_type.test", "node_type.memory", "16Gi"),
+					tfresource.TestCheckResourceAttr("altinitycloud_node_type.test", "node_type.memory_alloc_mb", "16384"),
+					tfresource.TestCheckResourceAttr("altinitycloud_node_type.test", "node_type.node_selector.disktype", "ssd"),
+				),
+			},
+			// Same size written in API units plans no changes
+			{
+				Config:   testAccProviderConfig(s) + testAccNodeTypeResourceConfig("tf_acc", "16384"),
+				PlanOnly: true,
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "altinitycloud_node_type.test",
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccNodeTypeImportID,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "node_type.id",
+				ImportStateVerifyIgnore:              []string{"last_updated", "node_type.memory"},
+			},
+			// Rename in place
+			{
+				Config: testAccProviderConfig(s) + testAccNodeTypeResourceConfig("tf_acc_renamed", "16Gi"),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr("altinitycloud_node_type.test", "node_type.name", "tf_acc_renamed"),
+				),
+			},
+		},
+	})
+}
+
+// testAccNodeTypeImportID - returns the "<env_id>/<node_type_id>" import ID of the test node type.
+func testAccNodeTypeImportID(st *terraform.State) (string, error) {
+	rs, ok := st.RootModule().Resources["altinitycloud_node_type.test"]
+	if !ok {
+		return "", fmt.Errorf("altinitycloud_node_type.test not found in state")
+	}
+	return rs.Primary.Attributes["env_id"] + "/" + rs.Primary.Attributes["node_type.id"], nil
+}
+
+func testAccNodeTypeResourceConfig(name, memory string) string {
+	return fmt.Sprintf(`
+resource "altinitycloud_node_type" "test" {
+  env_id = "648"
+  node_type = {
+    name          = %q
+    scope         = "ClickHouse"
+    code          = "m6i"
+    pool          = "m6i.xlarge"
+    storage_class = "gp3"
+    cpu           = "4"
+    memory        = %q
+    node_selector = {
+      disktype = "ssd"
+    }
+  }
+}
+`, name, memory)
+}
@@ -0,0 +1,303 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/tatari-tv/terraform-provider-altinitycloud/cmd/client"
+	"regexp"
+	"sort"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &nodeTypesDataSource{}
+	_ datasource.DataSourceWithConfigure = &nodeTypesDataSource{}
+)
+
+func NewNodeTypesDataSource() datasource.DataSource {
+	return &nodeTypesDataSource{}
+}
+
+// nodeTypesDataSource - defines the node types data source implementation.
+type nodeTypesDataSource struct {
+	client *client.AltinityCloudClient
+}
+
+// nodeTypeFilter - criteria a node type has to match to be returned.
+type nodeTypeFilter struct {
+	Scope        string
+	StorageClass string
+	Pool         string
+	NameRegex    *regexp.Regexp
+	MinCPU       *float64
+	MinMemory    *float64
+}
+
+// Metadata - returns the altinitycloud_node_types type name.
+func (d *nodeTypesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_node_types"
+}
+
+// Schema - defines the node_types schema.
+func (d *nodeTypesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists node types of an Altinity.Cloud environment matching all the given filters. " +
+			"Node types are sorted by CPU and then memory, so the first one is the smallest match.",
+		Attributes: map[string]schema.Attribute{
+			"env_id": schema.StringAttribute{
+				MarkdownDescription: "Altinity.Cloud environment ID",
+				Required:            true,
+			},
+			"scope": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return node types with this scope (either `ClickHouse` or `Zookeeper`).",
+				Validators: []validator.String{
+					stringvalidator.OneOf(nodeTypeScopes...),
+				},
+			},
+			"storage_class": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return node types with this Kubernetes disk storage class.",
+			},
+			"pool": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return node types with this Kubernetes provider label name.",
+			},
+			"name_regex": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return node types with a name matching this regular expression.",
+			},
+			"min_cpu": schema.Float64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Only return node types with at least this many CPU cores.",
+			},
+			"min_memory": schema.Float64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Only return node types with at least this much memory in MB.",
+			},
+			"node_types": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Node types matching the filters.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Altinity.Cloud node type ID.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Altinity.Cloud node type name.",
+						},
+						"scope": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Kubernetes node type scope (either `ClickHouse` or `Zookeeper`).",
+						},
+						"code": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Name Identifier for the node type.",
+						},
+						"pool": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Kubernetes provider label name.",
+						},
+						"storage_class": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Kubernetes disk storage class type (either `gp2 or `gp3`).",
+						},
+						"memory": schema.StringAttribute{
+							Computed:            true,
+							CustomType:          memoryQuantityType,
+							MarkdownDescription: "Kubernetes node memory size in MB.",
+						},
+						"cpu": schema.StringAttribute{
+							Computed:            true,
+							CustomType:          cpuQuantityType,
+							MarkdownDescription: "Kubernetes node CPU size in cores.",
+						},
+						"extra_spec": schema.StringAttribute{
+							Computed:            true,
+							CustomType:          jsontypes.NormalizedType{},
+							MarkdownDescription: "Extra specification for the node type as a JSON document.",
+						},
+						"node_selector": schema.MapAttribute{
+							Computed:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "Kubernetes node selector labels.",
+						},
+						"tolerations": schema.ListNestedAttribute{
+							Computed:            true,
+							MarkdownDescription: "Kubernetes node tolerations.",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"key": schema.StringAttribute{
+										Computed: true,
+									},
+									"operator": schema.StringAttribute{
+										Computed: true,
+									},
+									"effect": schema.StringAttribute{
+										Computed: true,
+									},
+									"value": schema.StringAttribute{
+										Computed: true,
+									},
+								},
+							},
+						},
+						"cpu_alloc": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Kubernetes node CPU allocation in cores.",
+						},
+						"memory_alloc": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Kubernetes node memory allocation in MB.",
+						},
+						"cpu_alloc_cores": schema.Float64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Kubernetes node CPU allocation in cores as a number.",
+						},
+						"memory_alloc_mb": schema.Float64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Kubernetes node memory allocation in MB as a number.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure - bootstraps node types datasource with Altinity.Cloud client.
+func (d *nodeTypesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Info(ctx, "Configuring node types data source")
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.AltinityCloudClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *altinityCloudClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Read - lists the node types of the environment and applies the filters.
+func (d *nodeTypesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading node types data source")
+	var state NodeTypesDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := nodeTypeFilter{
+		Scope:        state.Scope.ValueString(),
+		StorageClass: state.StorageClass.ValueString(),
+		Pool:         state.Pool.ValueString(),
+	}
+	if !state.NameRegex.IsNull() {
+		re, err := regexp.Compile(state.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name_regex"),
+				"Invalid Name Regex",
+				fmt.Sprintf("Could not compile name_regex: %s", err),
+			)
+			return
+		}
+		filter.NameRegex = re
+	}
+	if !state.MinCPU.IsNull() {
+		v := state.MinCPU.ValueFloat64()
+		filter.MinCPU = &v
+	}
+	if !state.MinMemory.IsNull() {
+		v := state.MinMemory.ValueFloat64()
+		filter.MinMemory = &v
+	}
+
+	nts, err := d.client.GetNodeTypes(ctx, state.EnvID.ValueString())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Client Error", "Unable to list node types", err, nil)
+		return
+	}
+
+	state.NodeTypes = []NodeTypeModel{}
+	for _, nt := range filterNodeTypes(nts.NodeTypes, filter) {
+		state.NodeTypes = append(state.NodeTypes, mapNodeTypeToNodeTypeResponse(nt))
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("found %d of %d node types in environment %v", len(state.NodeTypes), len(nts.NodeTypes), state.EnvID))
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// filterNodeTypes - returns node types matching the filter, sorted by CPU and memory ascending.
+func filterNodeTypes(nts []client.NodeType, f nodeTypeFilter) []client.NodeType {
+	matched := []client.NodeType{}
+	for _, nt := range nts {
+		if f.Scope != "" && nt.Scope != f.Scope {
+			continue
+		}
+		if f.StorageClass != "" && nt.StorageClass != f.StorageClass {
+			continue
+		}
+		if f.Pool != "" && nt.Pool != f.Pool {
+			continue
+		}
+		if f.NameRegex != nil && !f.NameRegex.MatchString(nt.Name) {
+			continue
+		}
+		if f.MinCPU != nil && cpuOf(nt) < *f.MinCPU {
+			continue
+		}
+		if f.MinMemory != nil && memoryOf(nt) < *f.MinMemory {
+			continue
+		}
+		matched = append(matched, nt)
+	}
+
+	sort.SliceStable(matched, func(i, j int) bool {
+		ci, cj := cpuOf(matched[i]), cpuOf(matched[j])
+		if ci != cj {
+			return ci < cj
+		}
+		return memoryOf(matched[i]) < memoryOf(matched[j])
+	})
+
+	return matched
+}
+
+// cpuOf - returns node type CPU size in cores, unparsable values count as zero.
+func cpuOf(nt client.NodeType) float64 {
+	v, _ := client.ParseCPU(nt.CPU)
+	return v
+}
+
+// memoryOf - returns node type memory size in MB, unparsable values count as zero.
+func memoryOf(nt client.NodeType) float64 {
+	v, _ := client.ParseMemory(nt.Memory)
+	return v
+}
@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/tatari-tv/terraform-provider-altinitycloud/cmd/client"
+	"github.com/tatari-tv/terraform-provider-altinitycloud/internal/fakeacm"
+	"regexp"
+	"testing"
+)
+
+func TestFilterNodeTypes(t *testing.T) {
+	nts := []client.NodeType{
+		{Name: "m6i.4xlarge", Scope: "ClickHouse", StorageClass: "gp3", Pool: "m6i", CPU: "16", Memory: "65536"},
+		{Name: "m6i.8xlarge", Scope: "ClickHouse", StorageClass: "gp3", Pool: "m6i", CPU: "32", Memory: "131072"},
+		{Name: "r6i.4xlarge", Scope: "ClickHouse", StorageClass: "gp2", Pool: "r6i", CPU: "16", Memory: "131072"},
+		{Name: "m6i.large", Scope: "Zookeeper", StorageClass: "gp3", Pool: "m6i", CPU: "2", Memory: "8192"},
+	}
+
+	names := func(nts []client.NodeType) []string {
+		res := []string{}
+		for _, nt := range nts {
+			res = append(res, nt.Name)
+		}
+		return res
+	}
+	float := func(v float64) *float64 { return &v }
+
+	assert.Equal(t, []string{"m6i.large", "m6i.4xlarge", "r6i.4xlarge", "m6i.8xlarge"}, names(filterNodeTypes(nts, nodeTypeFilter{})))
+	assert.Equal(t, []string{"m6i.4xlarge", "r6i.4xlarge", "m6i.8xlarge"}, names(filterNodeTypes(nts, nodeTypeFilter{Scope: "ClickHouse", MinCPU: float(16)})))
+	assert.Equal(t, []string{"r6i.4xlarge", "m6i.8xlarge"}, names(filterNodeTypes(nts, nodeTypeFilter{MinMemory: float(100000)})))
+	assert.Equal(t, []string{"r6i.4xlarge"}, names(filterNodeTypes(nts, nodeTypeFilter{StorageClass: "gp2"})))
+	assert.Equal(t, []string{"m6i.large", "m6i.4xlarge", "m6i.8xlarge"}, names(filterNodeTypes(nts, nodeTypeFilter{Pool: "m6i"})))
+	assert.Equal(t, []string{"m6i.4xlarge", "r6i.4xlarge"}, names(filterNodeTypes(nts, nodeTypeFilter{NameRegex: regexp.MustCompile(`\.4xlarge$`)})))
+	assert.Empty(t, filterNodeTypes(nts, nodeTypeFilter{MinCPU: float(64)}))
+}
+
+func TestAccNodeTypesDataSource(t *testing.T) {
+	s := fakeacm.NewServer(t)
+	s.AddNodeType("648", client.NodeType{Name: "m6i.8xlarge", Scope: "ClickHouse", CPU: "32", Memory: "131072"})
+	s.AddNodeType("648", client.NodeType{Name: "m6i.4xlarge", Scope: "ClickHouse", CPU: "16", Memory: "64Gi", MemoryAlloc: "60Gi"})
+	s.AddNodeType("648", client.NodeType{Name: "m6i.large", Scope: "Zookeeper", CPU: "2", Memory: "8192"})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(s) + `
+data "altinitycloud_node_types" "test" {
+  env_id  = "648"
+  scope   = "ClickHouse"
+  min_cpu = 16
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.altinitycloud_node_types.test", "node_types.#", "2"),
+					resource.TestCheckResourceAttr("data.altinitycloud_node_types.test", "node_types.0.name", "m6i.4xlarge"),
+					resource.TestCheckResourceAttr("data.altinitycloud_node_types.test", "node_types.0.memory_alloc_mb", "61440"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,316 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/tatari-tv/terraform-provider-altinitycloud/cmd/client"
+	"time"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &privateEndpointConnectionResource{}
+	_ resource.ResourceWithConfigure   = &privateEndpointConnectionResource{}
+	_ resource.ResourceWithImportState = &privateEndpointConnectionResource{}
+	_ resource.ResourceWithModifyPlan  = &privateEndpointConnectionResource{}
+)
+
+// NewPrivateEndpointConnectionResource is a helper function to simplify the provider implementation.
+func NewPrivateEndpointConnectionResource() resource.Resource {
+	return &privateEndpointConnectionResource{}
+}
+
+// privateEndpointConnectionResource is the resource implementation.
+type privateEndpointConnectionResource struct {
+	client *client.AltinityCloudClient
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *privateEndpointConnectionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.AltinityCloudClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Private Endpoint Connection Source Configure Type",
+			fmt.Sprintf("Expected *client.AltinityCloudClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Metadata - returns the resource type name.
+func (r *privateEndpointConnectionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_private_endpoint_connection"
+}
+
+// Schema - defines the schema for the resource.
+func (r *privateEndpointConnectionResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Requests approval of a consumer VPC endpoint connected to the private endpoint service of an " +
+			"Altinity.Cloud environment and waits for it to be accepted. A rejected connection is replaced on the next apply, " +
+			"destroying the resource removes the connection.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Altinity.Cloud private endpoint connection ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"env_id": schema.StringAttribute{
+				Required: true,
+				MarkdownDescription: "Altinity.Cloud environment ID. Reference `altinitycloud_private_endpoint_service.env_id` " +
+					"so the connection is created after, and removed before, the service.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"endpoint_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "ID of the consumer endpoint, e.g. the `id` of an `aws_vpc_endpoint` or the `psc_connection_id` of a `google_compute_forwarding_rule`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Description of the connection shown in Altinity.Cloud.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Private endpoint connection status (`pending`, `available`, `rejected` or `failed`).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Private endpoint connection last updated timestamp. This is auto-generated by the provider.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+// Create - requests the connection, waits for it to be accepted and sets the initial Terraform state.
+func (r *privateEndpointConnectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating private endpoint connection resource")
+	// Retrieve values from plan
+	var plan PrivateEndpointConnectionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Failed to retrieve plan for Altinity.Cloud private endpoint connection resource")
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, privateEndpointTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Request new connection
+	tflog.Info(ctx, fmt.Sprintf("Connecting endpoint %s to environment %s", plan.EndpointID.ValueString(), plan.EnvID.ValueString()))
+	conn, err := r.client.CreatePrivateEndpointConnection(ctx, plan.EnvID.ValueString(), mapPrivateEndpointConnectionModelToPrivateEndpointConnection(plan))
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error creating private endpoint connection", "Could not request private endpoint connection", err, privateEndpointConnectionFieldPaths)
+		return
+	}
+
+	// Wait for the connection to be accepted, a rejected or pending connection is saved to state and tainted
+	waited, err := r.client.WaitForPrivateEndpointConnection(ctx, conn.ID)
+	if waited.ID != "" {
+		conn = waited
+	}
+	if err != nil {
+		addWaitError(&resp.Diagnostics, "Error creating private endpoint connection", "Private endpoint connection "+conn.ID+" was not accepted", err)
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	mapPrivateEndpointConnectionToPrivateEndpointConnectionModel(conn, &plan)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	// Set plan to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *privateEndpointConnectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Read Altinity.Cloud private endpoint connection resource")
+	// Get current state
+	var state PrivateEndpointConnectionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed connection from Altinity.Cloud, it may have been removed outside Terraform
+	conn, err := r.client.GetPrivateEndpointConnection(ctx, state.ID.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, fmt.Sprintf("private endpoint connection %s not found, removing it from state", state.ID.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error retrieving private endpoint connection", "Could not retrieve private endpoint connection", err, nil)
+		return
+	}
+
+	// Overwrite current state with refreshed data
+	mapPrivateEndpointConnectionToPrivateEndpointConnectionModel(conn, &state)
+
+	tflog.Trace(ctx, fmt.Sprintf("refreshed private endpoint connection %s from API", state.ID.ValueString()))
+
+	// set refreshed state
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update - changes the connection description and sets the updated Terraform state on success.
+func (r *privateEndpointConnectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Update private endpoint connection resource")
+	// Retrieve values from plan
+	var plan PrivateEndpointConnectionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Failed to retrieve plan for Altinity.Cloud private endpoint connection resource")
+		return
+	}
+
+	// Update connection in Altinity.Cloud
+	tflog.Info(ctx, fmt.Sprintf("Updating private endpoint connection %s", plan.ID.ValueString()))
+	conn, err := r.client.UpdatePrivateEndpointConnection(ctx, mapPrivateEndpointConnectionModelToPrivateEndpointConnection(plan))
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error updating private endpoint connection", "Could not update private endpoint connection", err, privateEndpointConnectionFieldPaths)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	mapPrivateEndpointConnectionToPrivateEndpointConnectionModel(conn, &plan)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	// Set plan to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete - removes the connection and removes the Terraform state on success.
+func (r *privateEndpointConnectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Delete private endpoint connection resource")
+	// Retrieve values from state
+	var state PrivateEndpointConnectionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Remove connection, it may already be gone
+	err := r.client.DeletePrivateEndpointConnection(ctx, state.ID.ValueString())
+	if client.IsNotFound(err) {
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error deleting private endpoint connection", "Could not remove private endpoint connection", err, nil)
+		return
+	}
+}
+
+// ModifyPlan - replaces connections that were rejected or failed since they were accepted,
+// e.g. when the endpoint owner was removed from the allowed principals.
+func (r *privateEndpointConnectionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// nothing to compare on create and destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state PrivateEndpointConnectionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	switch state.Status.ValueString() {
+	case client.PrivateEndpointStatusRejected, client.PrivateEndpointStatusFailed:
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("status"), types.StringUnknown())...)
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("status"))
+	}
+}
+
+// ImportState - imports an existing private endpoint connection by its Altinity.Cloud ID.
+func (r *privateEndpointConnectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, "Import private endpoint connection resource")
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// mapPrivateEndpointConnectionModelToPrivateEndpointConnection - converts the Terraform model into an API request.
+func mapPrivateEndpointConnectionModelToPrivateEndpointConnection(m PrivateEndpointConnectionResourceModel) client.PrivateEndpointConnection {
+	return client.PrivateEndpointConnection{
+		ID:          m.ID.ValueString(),
+		EnvID:       m.EnvID.ValueString(),
+		EndpointID:  m.EndpointID.ValueString(),
+		Description: m.Description.ValueString(),
+	}
+}
+
+// mapPrivateEndpointConnectionToPrivateEndpointConnectionModel - copies the API response into the Terraform model.
+func mapPrivateEndpointConnectionToPrivateEndpointConnectionModel(conn client.PrivateEndpointConnection, m *PrivateEndpointConnectionResourceModel) {
+	m.ID = types.StringValue(conn.ID)
+	m.Status = types.StringValue(conn.Status)
+
+	// the API does not echo the environment and endpoint when the connection could not be read
+	if len(conn.EnvID) > 0 {
+		m.EnvID = types.StringValue(conn.EnvID)
+	}
+	if len(conn.EndpointID) > 0 {
+		m.EndpointID = types.StringValue(conn.EndpointID)
+	}
+
+	m.Description = types.StringNull()
+	if len(conn.Description) > 0 {
+		m.Description = types.StringValue(conn.Description)
+	}
+}
@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/tatari-tv/terraform-provider-altinitycloud/cmd/client"
+	"github.com/tatari-tv/terraform-provider-altinitycloud/internal/fakeacm"
+	"regexp"
+	"testing"
+)
+
+func TestAccPrivateEndpointConnectionResource(t *testing.T) {
+	s := fakeacm.NewServer(t)
+	s.PrivateEndpointPolls = 1
+	s.RejectedEndpoint = "vpce-0badbadbadbadbad0"
+	env := s.AddEnvironment(client.Environment{Name: "prod", Cloud: "aws", Region: "us-east-1"})
+	var connID string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// A rejected connection fails the apply and is saved to state
+			{
+				Config:      testAccProviderConfig(s) + testAccPrivateEndpointConnectionResourceConfig(env.ID, "vpce-0badbadbadbadbad0", "analytics"),
+				ExpectError: regexp.MustCompile(`reported\s+status\s+rejected:\s+endpoint\s+is\s+not\s+owned`),
+			},
+			// The tainted connection is replaced once the endpoint is fixed
+			{
+				Config: testAccProviderConfig(s) + testAccPrivateEndpointConnectionResourceConfig(env.ID, "vpce-0123456789abcdef0", "analytics"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("altinitycloud_private_endpoint_connection.test", plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCaptureID("altinitycloud_private_endpoint_connection.test", &connID),
+					resource.TestCheckResourceAttr("altinitycloud_private_endpoint_connection.test", "status", client.PrivateEndpointStatusAvailable),
+					resource.TestCheckResourceAttr("altinitycloud_private_endpoint_connection.test", "description", "analytics"),
+				),
+			},
+			// The description is changed in place
+			{
+				Config: testAccProviderConfig(s) + testAccPrivateEndpointConnectionResourceConfig(env.ID, "vpce-0123456789abcdef0", "reporting"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPtr("altinitycloud_private_endpoint_connection.test", "id", &connID),
+					resource.TestCheckResourceAttr("altinitycloud_private_endpoint_connection.test", "description", "reporting"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "altinitycloud_private_endpoint_connection.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			// A connection rejected outside Terraform is planned for replacement
+			{
+				PreConfig: func() { s.RejectPrivateEndpointConnection(connID) },
+				Config:    testAccProviderConfig(s) + testAccPrivateEndpointConnectionResourceConfig(env.ID, "vpce-0123456789abcdef0", "reporting"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("altinitycloud_private_endpoint_connection.test", plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("altinitycloud_private_endpoint_connection.test", "status", client.PrivateEndpointStatusAvailable),
+				),
+			},
+		},
+	})
+}
+
+func testAccPrivateEndpointConnectionResourceConfig(envID, endpointID, description string) string {
+	return fmt.Sprintf(`
+resource "altinitycloud_private_endpoint_service" "test" {
+  env_id             = %q
+  allowed_principals = ["arn:aws:iam::123456789012:root"]
+}
+
+resource "altinitycloud_private_endpoint_connection" "test" {
+  env_id      = altinitycloud_private_endpoint_service.test.env_id
+  endpoint_id = %q
+  description = %q
+}
+`, envID, endpointID, description)
+}
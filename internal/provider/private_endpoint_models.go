@@ -0,0 +1,30 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// PrivateEndpointServiceResourceModel - describes the private endpoint service model for resources.
+type PrivateEndpointServiceResourceModel struct {
+	ID                types.String   `tfsdk:"id"`
+	EnvID             types.String   `tfsdk:"env_id"`
+	AllowedPrincipals types.List     `tfsdk:"allowed_principals"`
+	Cloud             types.String   `tfsdk:"cloud"`
+	ServiceName       types.String   `tfsdk:"service_name"`
+	DNSName           types.String   `tfsdk:"dns_name"`
+	Status            types.String   `tfsdk:"status"`
+	LastUpdated       types.String   `tfsdk:"last_updated"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+}
+
+// PrivateEndpointConnectionResourceModel - describes the private endpoint connection model for resources.
+type PrivateEndpointConnectionResourceModel struct {
+	ID          types.String   `tfsdk:"id"`
+	EnvID       types.String   `tfsdk:"env_id"`
+	EndpointID  types.String   `tfsdk:"endpoint_id"`
+	Description types.String   `tfsdk:"description"`
+	Status      types.String   `tfsdk:"status"`
+	LastUpdated types.String   `tfsdk:"last_updated"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
@@ -0,0 +1,327 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/tatari-tv/terraform-provider-altinitycloud/cmd/client"
+	"time"
+)
+
+// privateEndpointTimeout - how long to wait for a private endpoint service or connection to become available.
+const privateEndpointTimeout = 30 * time.Minute
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &privateEndpointServiceResource{}
+	_ resource.ResourceWithConfigure   = &privateEndpointServiceResource{}
+	_ resource.ResourceWithImportState = &privateEndpointServiceResource{}
+)
+
+// NewPrivateEndpointServiceResource is a helper function to simplify the provider implementation.
+func NewPrivateEndpointServiceResource() resource.Resource {
+	return &privateEndpointServiceResource{}
+}
+
+// privateEndpointServiceResource is the resource implementation.
+type privateEndpointServiceResource struct {
+	client *client.AltinityCloudClient
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *privateEndpointServiceResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.AltinityCloudClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Private Endpoint Service Source Configure Type",
+			fmt.Sprintf("Expected *client.AltinityCloudClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Metadata - returns the resource type name.
+func (r *privateEndpointServiceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_private_endpoint_service"
+}
+
+// Schema - defines the schema for the resource.
+func (r *privateEndpointServiceResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Enables the AWS PrivateLink or GCP Private Service Connect service of an Altinity.Cloud environment " +
+			"and waits for it to become available. Consumer VPC endpoints connect to `service_name` and reach the clusters " +
+			"of the environment under `dns_name`. Destroying the resource disables the service, which fails while it still has connections.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Private endpoint service ID, same as `env_id`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"env_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Altinity.Cloud environment ID. Only AWS and GCP environments support private endpoints.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"allowed_principals": schema.ListAttribute{
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				MarkdownDescription: "Principals allowed to connect endpoints to the service, e.g. `arn:aws:iam::123456789012:root` " +
+					"in AWS or a project ID in GCP. Connections from other principals are rejected. Defaults to none.",
+				Default: listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
+				Validators: []validator.List{
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"cloud": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Cloud provider of the environment (`aws` or `gcp`).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service_name": schema.StringAttribute{
+				Computed: true,
+				MarkdownDescription: "Service name consumer endpoints connect to, e.g. the `service_name` of an `aws_vpc_endpoint` " +
+					"or the `target` of a `google_compute_forwarding_rule`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dns_name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "DNS name the clusters of the environment are reachable under through a connected endpoint.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Private endpoint service status (`provisioning`, `available` or `failed`).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Private endpoint service last updated timestamp. This is auto-generated by the provider.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+// Create - enables the private endpoint service, waits for it to become available and sets the initial Terraform state.
+func (r *privateEndpointServiceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating private endpoint service resource")
+	// Retrieve values from plan
+	var plan PrivateEndpointServiceResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Failed to retrieve plan for Altinity.Cloud private endpoint service resource")
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, privateEndpointTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	service, diags := mapPrivateEndpointServiceModelToPrivateEndpointService(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Enable private endpoint service
+	tflog.Info(ctx, fmt.Sprintf("Enabling private endpoint service of environment %s", plan.EnvID.ValueString()))
+	service, err := r.client.SetPrivateEndpointService(ctx, service)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error creating private endpoint service", "Could not enable private endpoint service", err, privateEndpointServiceFieldPaths)
+		return
+	}
+
+	// Wait for the service to become available, a failed or unfinished service is saved to state and tainted
+	waited, err := r.client.WaitForPrivateEndpointService(ctx, plan.EnvID.ValueString())
+	if waited.EnvID != "" {
+		service = waited
+	}
+	if err != nil {
+		addWaitError(&resp.Diagnostics, "Error creating private endpoint service",
+			"Private endpoint service of environment "+plan.EnvID.ValueString()+" did not become available", err)
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	mapPrivateEndpointServiceToPrivateEndpointServiceModel(service, &plan)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	// Set plan to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *privateEndpointServiceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Read Altinity.Cloud private endpoint service resource")
+	// Get current state
+	var state PrivateEndpointServiceResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed private endpoint service from Altinity.Cloud, it may have been disabled outside Terraform
+	service, err := r.client.GetPrivateEndpointService(ctx, state.EnvID.ValueString())
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, fmt.Sprintf("private endpoint service of environment %s not found, removing it from state", state.EnvID.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error retrieving private endpoint service", "Could not retrieve private endpoint service", err, nil)
+		return
+	}
+
+	// Overwrite current state with refreshed data
+	mapPrivateEndpointServiceToPrivateEndpointServiceModel(service, &state)
+
+	tflog.Trace(ctx, fmt.Sprintf("refreshed private endpoint service of environment %s from API", state.EnvID.ValueString()))
+
+	// set refreshed state
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update - changes the allowed principals and sets the updated Terraform state on success.
+func (r *privateEndpointServiceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Update private endpoint service resource")
+	// Retrieve values from plan
+	var plan PrivateEndpointServiceResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Failed to retrieve plan for Altinity.Cloud private endpoint service resource")
+		return
+	}
+
+	service, diags := mapPrivateEndpointServiceModelToPrivateEndpointService(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update allowed principals in Altinity.Cloud
+	tflog.Info(ctx, fmt.Sprintf("Updating allowed principals of private endpoint service of environment %s", plan.EnvID.ValueString()))
+	service, err := r.client.SetPrivateEndpointService(ctx, service)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error updating private endpoint service", "Could not update private endpoint service", err, privateEndpointServiceFieldPaths)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	mapPrivateEndpointServiceToPrivateEndpointServiceModel(service, &plan)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	// Set plan to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete - disables the private endpoint service and removes the Terraform state on success.
+func (r *privateEndpointServiceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Delete private endpoint service resource")
+	// Retrieve values from state
+	var state PrivateEndpointServiceResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Disable private endpoint service, it may already be gone
+	err := r.client.DeletePrivateEndpointService(ctx, state.EnvID.ValueString())
+	if client.IsNotFound(err) {
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error deleting private endpoint service", "Could not disable private endpoint service", err, nil)
+		return
+	}
+}
+
+// ImportState - imports the private endpoint service of an environment by the environment ID.
+func (r *privateEndpointServiceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, "Import private endpoint service resource")
+	// Retrieve import ID and save to id and env_id attributes
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("env_id"), req.ID)...)
+}
+
+// mapPrivateEndpointServiceModelToPrivateEndpointService - converts the Terraform model into an API request.
+func mapPrivateEndpointServiceModelToPrivateEndpointService(ctx context.Context, m PrivateEndpointServiceResourceModel) (client.PrivateEndpointService, diag.Diagnostics) {
+	service := client.PrivateEndpointService{
+		EnvID:             m.EnvID.ValueString(),
+		AllowedPrincipals: []string{},
+	}
+
+	var diags diag.Diagnostics
+	if !m.AllowedPrincipals.IsNull() && !m.AllowedPrincipals.IsUnknown() {
+		diags.Append(m.AllowedPrincipals.ElementsAs(ctx, &service.AllowedPrincipals, false)...)
+	}
+
+	return service, diags
+}
+
+// mapPrivateEndpointServiceToPrivateEndpointServiceModel - copies the API response into the Terraform model.
+func mapPrivateEndpointServiceToPrivateEndpointServiceModel(service client.PrivateEndpointService, m *PrivateEndpointServiceResourceModel) {
+	m.ID = m.EnvID
+	m.AllowedPrincipals = mapStringList(service.AllowedPrincipals)
+	m.Cloud = types.StringValue(service.Cloud)
+	m.ServiceName = types.StringValue(service.ServiceName)
+	m.DNSName = types.StringValue(service.DNSName)
+	m.Status = types.StringValue(service.Status)
+}
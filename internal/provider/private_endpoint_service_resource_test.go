@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/tatari-tv/terraform-provider-altinitycloud/cmd/client"
+	"github.com/tatari-tv/terraform-provider-altinitycloud/internal/fakeacm"
+	"regexp"
+	"testing"
+)
+
+func TestAccPrivateEndpointServiceResource(t *testing.T) {
+	s := fakeacm.NewServer(t)
+	s.PrivateEndpointPolls = 2
+	env := s.AddEnvironment(client.Environment{Name: "prod", Cloud: "aws", Region: "us-east-1"})
+	azure := s.AddEnvironment(client.Environment{Name: "lab", Cloud: "azure", Region: "eastus"})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// API validation errors are reported on the attribute
+			{
+				Config:      testAccProviderConfig(s) + testAccPrivateEndpointServiceResourceConfig(azure.ID, `[]`),
+				ExpectError: regexp.MustCompile(`only\s+supported\s+in\s+aws\s+and\s+gcp\s+environments`),
+			},
+			{
+				Config:      testAccProviderConfig(s) + testAccPrivateEndpointServiceResourceConfig(env.ID, `["123456789012"]`),
+				ExpectError: regexp.MustCompile(`"123456789012"\s+is\s+not\s+an\s+AWS\s+principal\s+ARN`),
+			},
+			// Create waits for the service to become available
+			{
+				Config: testAccProviderConfig(s) + testAccPrivateEndpointServiceResourceConfig(env.ID, `["arn:aws:iam::123456789012:root"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("altinitycloud_private_endpoint_service.test", "id", env.ID),
+					resource.TestCheckResourceAttr("altinitycloud_private_endpoint_service.test", "cloud", "aws"),
+					resource.TestCheckResourceAttr("altinitycloud_private_endpoint_service.test", "status", client.PrivateEndpointStatusAvailable),
+					resource.TestMatchResourceAttr("altinitycloud_private_endpoint_service.test", "service_name",
+						regexp.MustCompile(`^com\.amazonaws\.vpce\.us-east-1\.vpce-svc-[0-9a-f]{17}$`)),
+					resource.TestCheckResourceAttr("altinitycloud_private_endpoint_service.test", "dns_name", "prod.privatelink.altinity.cloud"),
+					resource.TestCheckResourceAttr("altinitycloud_private_endpoint_service.test", "allowed_principals.#", "1"),
+				),
+			},
+			// Changing the allowed principals keeps the service name
+			{
+				Config: testAccProviderConfig(s) + testAccPrivateEndpointServiceResourceConfig(env.ID,
+					`["arn:aws:iam::123456789012:root", "arn:aws:iam::210987654321:root"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("altinitycloud_private_endpoint_service.test", "allowed_principals.#", "2"),
+					testAccCheckPrivateEndpointPrincipals(s, env.ID, 2),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "altinitycloud_private_endpoint_service.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+		},
+	})
+}
+
+// testAccCheckPrivateEndpointPrincipals - checks the number of principals the fake API stored for the environment.
+func testAccCheckPrivateEndpointPrincipals(s *fakeacm.Server, envID string, want int) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		ps, ok := s.PrivateEndpointService(envID)
+		if !ok {
+			return fmt.Errorf("private endpoint service of environment %s not found", envID)
+		}
+		if len(ps.AllowedPrincipals) != want {
+			return fmt.Errorf("private endpoint service of environment %s allows %v, want %d principals", envID, ps.AllowedPrincipals, want)
+		}
+		return nil
+	}
+}
+
+func testAccPrivateEndpointServiceResourceConfig(envID, principals string) string {
+	return fmt.Sprintf(`
+resource "altinitycloud_private_endpoint_service" "test" {
+  env_id             = %q
+  allowed_principals = %s
+}
+`, envID, principals)
+}
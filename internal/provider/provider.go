@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
@@ -10,7 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/tatari-tv/terraform-provider-altinitycloud/cmd/client"
-	"os"
+	"time"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -37,8 +38,15 @@ type altinityCloudProvider struct {
 
 // altinityCloudProviderModel - maps provider schema NodeTypes to a Go type.
 type altinityCloudProviderModel struct {
-	APIEndpoint types.String `tfsdk:"api_endpoint"`
-	APIToken    types.String `tfsdk:"api_token"`
+	APIEndpoint     types.String `tfsdk:"api_endpoint"`
+	APIToken        types.String `tfsdk:"api_token"`
+	Profile         types.String `tfsdk:"profile"`
+	CredentialsFile types.String `tfsdk:"credentials_file"`
+	RequestTimeout  types.Int64  `tfsdk:"request_timeout"`
+	MaxRetries      types.Int64  `tfsdk:"max_retries"`
+	RetryWaitMin    types.Int64  `tfsdk:"retry_wait_min"`
+	RetryWaitMax    types.Int64  `tfsdk:"retry_wait_max"`
+	PollInterval    types.Int64  `tfsdk:"poll_interval"`
 }
 
 // Metadata - returns the provider type name.
@@ -50,12 +58,52 @@ func (p *altinityCloudProvider) Metadata(_ context.Context, _ provider.MetadataR
 // Schema - defines the provider-level schema for configuration NodeTypes.
 func (p *altinityCloudProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages Altinity.Cloud environments, clusters and their settings through the Altinity.Cloud API.\n\n" +
+			"The API endpoint and token are each taken from the first of these that sets them:\n\n" +
+			"1. the `api_endpoint` and `api_token` attributes,\n" +
+			"2. the profile of the credentials file selected with `profile` or the `ALTINITY_CLOUD_PROFILE` environment variable,\n" +
+			"3. the `ALTINITY_CLOUD_ENDPOINT` and `ALTINITY_CLOUD_TOKEN` environment variables,\n" +
+			"4. the `default` profile of the credentials file, when no profile is selected.\n\n" +
+			"The credentials file, `~/.altinity/credentials` unless set with `credentials_file` or the `ALTINITY_CLOUD_CREDENTIALS_FILE` " +
+			"environment variable, holds one `[name]` section per profile with `api_endpoint` and `api_token` keys.",
 		Attributes: map[string]schema.Attribute{
 			"api_endpoint": schema.StringAttribute{
-				Optional: true,
+				Optional:            true,
+				MarkdownDescription: "Altinity.Cloud API endpoint, e.g. `https://acm.altinity.cloud/api`.",
 			},
 			"api_token": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Altinity.Cloud API token.",
+			},
+			"profile": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Profile of the credentials file to take the API endpoint and token from. Defaults to the `ALTINITY_CLOUD_PROFILE` environment variable.",
+			},
+			"credentials_file": schema.StringAttribute{
 				Optional: true,
+				MarkdownDescription: "Path of the credentials file. Defaults to the `ALTINITY_CLOUD_CREDENTIALS_FILE` environment variable, " +
+					"or `~/.altinity/credentials`.",
+			},
+			"request_timeout": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Timeout of a single Altinity.Cloud API request in seconds. Defaults to `10`.",
+			},
+			"max_retries": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Maximum number of retries of a failed idempotent Altinity.Cloud API request. Defaults to `4`.",
+			},
+			"retry_wait_min": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Minimum time to wait between retries in seconds, at most `retry_wait_max`. Defaults to `1`.",
+			},
+			"retry_wait_max": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Maximum time to wait between retries in seconds, at least `1` unless `max_retries` is `0`. Defaults to `30`.",
+			},
+			"poll_interval": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Time to wait between two status reads of a long-running operation, like a cluster launch or a backup, in seconds. Must be at least `1`. Defaults to `15`.",
 			},
 		},
 	}
@@ -93,23 +141,34 @@ func (p *altinityCloudProvider) Configure(ctx context.Context, req provider.Conf
 		)
 	}
 
+	for attr, value := range map[string]types.String{
+		"profile":          config.Profile,
+		"credentials_file": config.CredentialsFile,
+	} {
+		if value.IsUnknown() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attr),
+				"Unknown Altinity.Cloud Credentials Setting",
+				fmt.Sprintf("The provider cannot create the Altinity.Cloud API client as there is an unknown configuration value for %s. ", attr)+
+					"Either target apply the source of the value first or set the value statically in the configuration.",
+			)
+		}
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Default values to environment variables, but override
-	// with Terraform configuration value if set.
-
-	endpoint := os.Getenv("ALTINITY_CLOUD_ENDPOINT")
-	token := os.Getenv("ALTINITY_CLOUD_TOKEN")
-
-	if !config.APIEndpoint.IsNull() {
-		endpoint = config.APIEndpoint.ValueString()
-	}
+	// Take the endpoint and token from the configuration, the credentials
+	// file profile or the environment, in that order.
 
-	if !config.APIToken.IsNull() {
-		token = config.APIToken.ValueString()
+	credentials, diags := resolveAPICredentials(config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+	endpoint := credentials.Endpoint
+	token := credentials.Token
 
 	// If any of the expected configurations are missing, return
 	// errors with provider-specific guidance.
@@ -119,8 +178,8 @@ func (p *altinityCloudProvider) Configure(ctx context.Context, req provider.Conf
 			path.Root("api_endpoint"),
 			"Missing Altinity.Cloud API Endpoint",
 			"The provider cannot create the Altinity.Cloud API client as there is a missing or empty value for the API endpoint. "+
-				"Set the host value in the configuration or use the ALTINITY_CLOUD_ENDPOINT environment variable. "+
-				"If either is already set, ensure the value is not empty.",
+				"Set api_endpoint in the configuration or in the selected profile of the credentials file, or use the ALTINITY_CLOUD_ENDPOINT environment variable. "+
+				"If any of them is already set, ensure the value is not empty.",
 		)
 	}
 
@@ -129,8 +188,69 @@ func (p *altinityCloudProvider) Configure(ctx context.Context, req provider.Conf
 			path.Root("api_token"),
 			"Missing Altinity.Cloud API token",
 			"The provider cannot create the Altinity.Cloud API client as there is a missing or empty value for the Altinity.Cloud API token. "+
-				"Set the username value in the configuration or use the ALTINITY_CLOUD_TOKEN environment variable. "+
-				"If either is already set, ensure the value is not empty.",
+				"Set api_token in the configuration or in the selected profile of the credentials file, or use the ALTINITY_CLOUD_TOKEN environment variable. "+
+				"If any of them is already set, ensure the value is not empty.",
+		)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Transport settings must be non-negative when set.
+
+	for attr, value := range map[string]types.Int64{
+		"request_timeout": config.RequestTimeout,
+		"max_retries":     config.MaxRetries,
+		"retry_wait_min":  config.RetryWaitMin,
+		"retry_wait_max":  config.RetryWaitMax,
+	} {
+		if !value.IsNull() && !value.IsUnknown() && value.ValueInt64() < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attr),
+				"Invalid Altinity.Cloud Client Setting",
+				fmt.Sprintf("The provider cannot create the Altinity.Cloud API client as %s must not be negative, got: %d.", attr, value.ValueInt64()),
+			)
+		}
+	}
+
+	// Retries must back off, with the minimum wait not above the maximum.
+	maxRetries := int64(client.DefaultMaxRetries)
+	retryWaitMin := int64(client.DefaultRetryWaitMin / time.Second)
+	retryWaitMax := int64(client.DefaultRetryWaitMax / time.Second)
+	if !config.MaxRetries.IsNull() && !config.MaxRetries.IsUnknown() {
+		maxRetries = config.MaxRetries.ValueInt64()
+	}
+	if !config.RetryWaitMin.IsNull() && !config.RetryWaitMin.IsUnknown() {
+		retryWaitMin = config.RetryWaitMin.ValueInt64()
+	}
+	if !config.RetryWaitMax.IsNull() && !config.RetryWaitMax.IsUnknown() {
+		retryWaitMax = config.RetryWaitMax.ValueInt64()
+	}
+
+	if retryWaitMin > retryWaitMax {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retry_wait_min"),
+			"Invalid Altinity.Cloud Client Setting",
+			fmt.Sprintf("The provider cannot create the Altinity.Cloud API client as retry_wait_min (%d) must not be greater than retry_wait_max (%d).", retryWaitMin, retryWaitMax),
+		)
+	}
+
+	if retryWaitMax == 0 && maxRetries > 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retry_wait_max"),
+			"Invalid Altinity.Cloud Client Setting",
+			fmt.Sprintf("The provider cannot create the Altinity.Cloud API client as retry_wait_max must be at least 1 second when max_retries is %d. "+
+				"Set max_retries to 0 to disable retries.", maxRetries),
+		)
+	}
+
+	// Back-to-back status reads would run into the API rate limits for the whole wait.
+	if !config.PollInterval.IsNull() && !config.PollInterval.IsUnknown() && config.PollInterval.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("poll_interval"),
+			"Invalid Altinity.Cloud Client Setting",
+			fmt.Sprintf("The provider cannot create the Altinity.Cloud API client as poll_interval must be at least 1 second, got: %d.", config.PollInterval.ValueInt64()),
 		)
 	}
 
@@ -139,7 +259,12 @@ func (p *altinityCloudProvider) Configure(ctx context.Context, req provider.Conf
 	}
 
 	ctx = tflog.SetField(ctx, "api_endpoint", endpoint)
-	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "api_token", token)
+	ctx = tflog.SetField(ctx, "api_endpoint_source", credentials.EndpointSource)
+	ctx = tflog.SetField(ctx, "api_token", token)
+	ctx = tflog.SetField(ctx, "api_token_source", credentials.TokenSource)
+	ctx = tflog.SetField(ctx, "profile", credentials.Profile)
+	ctx = tflog.SetField(ctx, "credentials_file", credentials.CredentialsFile)
+	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "api_token")
 
 	tflog.Debug(ctx, "Creating Altiniy.Cloud client")
 
@@ -155,16 +280,55 @@ func (p *altinityCloudProvider) Configure(ctx context.Context, req provider.Conf
 		return
 	}
 
+	// Override the default transport settings with Terraform configuration values if set.
+	applyClientSettings(client, config)
+
+	tflog.Debug(ctx, fmt.Sprintf("Altinity.Cloud client timeout %s, max retries %d, retry wait %s-%s, poll interval %s",
+		client.HTTPClient.Timeout, client.MaxRetries, client.RetryWaitMin, client.RetryWaitMax, client.PollInterval))
+
 	// Make the Altinity.Cloud client available during DataSource and Resource
 	// type Configure methods.
 	resp.DataSourceData = client
 	resp.ResourceData = client
 }
 
+// applyClientSettings - overrides the default transport settings of the client with the configured ones.
+// Values unknown at this point, e.g. derived from another resource, keep the defaults rather than turning into zero.
+func applyClientSettings(c *client.AltinityCloudClient, config altinityCloudProviderModel) {
+	set := func(v types.Int64) bool {
+		return !v.IsNull() && !v.IsUnknown()
+	}
+
+	if set(config.RequestTimeout) {
+		c.HTTPClient.Timeout = time.Duration(config.RequestTimeout.ValueInt64()) * time.Second
+	}
+
+	if set(config.MaxRetries) {
+		c.MaxRetries = int(config.MaxRetries.ValueInt64())
+	}
+
+	if set(config.RetryWaitMin) {
+		c.RetryWaitMin = time.Duration(config.RetryWaitMin.ValueInt64()) * time.Second
+	}
+
+	if set(config.RetryWaitMax) {
+		c.RetryWaitMax = time.Duration(config.RetryWaitMax.ValueInt64()) * time.Second
+	}
+
+	if set(config.PollInterval) {
+		c.PollInterval = time.Duration(config.PollInterval.ValueInt64()) * time.Second
+	}
+}
+
 // DataSources - defines the NodeTypes sources implemented in the provider.
 func (p *altinityCloudProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		NewNodeTypeDataSource,
 		NewNodeTypesDataSource,
+		NewEnvironmentDataSource,
+		NewBackupsDataSource,
+		NewClickHouseVersionsDataSource,
+		NewClusterEndpointsDataSource,
 	}
 }
 
@@ -172,5 +336,16 @@ func (p *altinityCloudProvider) DataSources(_ context.Context) []func() datasour
 func (p *altinityCloudProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewNodeTypeResource,
+		NewClusterResource,
+		NewEnvironmentResource,
+		NewClusterUserResource,
+		NewClusterSettingResource,
+		NewClusterProfileResource,
+		NewClusterScheduleResource,
+		NewClusterIPAllowlistResource,
+		NewPrivateEndpointServiceResource,
+		NewPrivateEndpointConnectionResource,
+		NewBackupScheduleResource,
+		NewBackupResource,
 	}
 }
@@ -0,0 +1,107 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/tatari-tv/terraform-provider-altinitycloud/cmd/client"
+	"github.com/tatari-tv/terraform-provider-altinitycloud/internal/fakeacm"
+	"regexp"
+	"testing"
+	"time"
+)
+
+// testAccProtoV6ProviderFactories - instantiates the provider for acceptance tests.
+var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	"altinitycloud": providerserver.NewProtocol6WithError(New("test")()),
+}
+
+// testAccProviderConfig - provider block pointing at the fake Altinity.Cloud API.
+// Status polls use the shortest interval allowed, so slow operations of the fake API take seconds.
+func testAccProviderConfig(s *fakeacm.Server) string {
+	return fmt.Sprintf(`
+provider "altinitycloud" {
+  api_endpoint  = %q
+  api_token     = %q
+  poll_interval = 1
+}
+`, s.URL, fakeacm.Token)
+}
+
+func TestApplyClientSettings(t *testing.T) {
+	c, err := client.NewClient(nil, nil)
+	assert.Nil(t, err)
+
+	applyClientSettings(c, altinityCloudProviderModel{
+		RequestTimeout: types.Int64Unknown(),
+		MaxRetries:     types.Int64Value(2),
+		RetryWaitMin:   types.Int64Null(),
+		RetryWaitMax:   types.Int64Unknown(),
+		PollInterval:   types.Int64Value(5),
+	})
+	// unknown values keep the defaults, a zero request timeout would disable it
+	assert.Equal(t, client.DefaultRequestTimeout, c.HTTPClient.Timeout)
+	assert.Equal(t, 2, c.MaxRetries)
+	assert.Equal(t, client.DefaultRetryWaitMin, c.RetryWaitMin)
+	assert.Equal(t, client.DefaultRetryWaitMax, c.RetryWaitMax)
+	assert.Equal(t, 5*time.Second, c.PollInterval)
+}
+
+func TestAccProviderRetrySettings(t *testing.T) {
+	s := fakeacm.NewServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProviderSettingsConfig(s, "retry_wait_min = 60"),
+				ExpectError: regexp.MustCompile(`retry_wait_min\s+\(60\)\s+must\s+not\s+be\s+greater\s+than\s+retry_wait_max\s+\(30\)`),
+			},
+			{
+				Config:      testAccProviderSettingsConfig(s, "retry_wait_min = 0\n  retry_wait_max = 0"),
+				ExpectError: regexp.MustCompile(`retry_wait_max\s+must\s+be\s+at\s+least\s+1\s+second\s+when\s+max_retries\s+is\s+4`),
+			},
+			// Without retries there is nothing to wait for
+			{
+				Config: testAccProviderSettingsConfig(s, "max_retries = 0\n  retry_wait_min = 0\n  retry_wait_max = 0"),
+				Check:  resource.TestCheckResourceAttr("data.altinitycloud_backups.test", "backups.#", "0"),
+			},
+		},
+	})
+}
+
+func TestAccProviderPollInterval(t *testing.T) {
+	s := fakeacm.NewServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProviderSettingsConfig(s, "poll_interval = 0"),
+				ExpectError: regexp.MustCompile(`poll_interval\s+must\s+be\s+at\s+least\s+1\s+second`),
+			},
+			{
+				Config: testAccProviderSettingsConfig(s, "poll_interval = 1"),
+				Check:  resource.TestCheckResourceAttr("data.altinitycloud_backups.test", "backups.#", "0"),
+			},
+		},
+	})
+}
+
+// testAccProviderSettingsConfig - provider block with the given client settings and a data source that needs the client.
+func testAccProviderSettingsConfig(s *fakeacm.Server, settings string) string {
+	return fmt.Sprintf(`
+provider "altinitycloud" {
+  api_endpoint = %q
+  api_token    = %q
+  %s
+}
+
+data "altinitycloud_backups" "test" {
+  cluster_id = "42"
+}
+`, s.URL, fakeacm.Token, settings)
+}
@@ -0,0 +1,172 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/tatari-tv/terraform-provider-altinitycloud/cmd/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ basetypes.StringTypable                    = quantityType{}
+	_ basetypes.StringValuableWithSemanticEquals = quantityValue{}
+	_ planmodifier.String                        = quantityPlanModifier{}
+)
+
+// quantityType - string attribute holding a CPU or memory size that can be written either
+// in the API units (cores, MB) or as a Kubernetes quantity such as `500m` or `16Gi`.
+type quantityType struct {
+	basetypes.StringType
+	memory bool
+}
+
+// cpuQuantityType and memoryQuantityType - custom types of the node type cpu and memory attributes.
+var (
+	cpuQuantityType    = quantityType{memory: false}
+	memoryQuantityType = quantityType{memory: true}
+)
+
+// Equal - returns true if the given type is equivalent.
+func (t quantityType) Equal(o attr.Type) bool {
+	other, ok := o.(quantityType)
+	return ok && t.memory == other.memory
+}
+
+// String - returns a human readable string of the type name.
+func (t quantityType) String() string {
+	if t.memory {
+		return "memoryQuantityType"
+	}
+	return "cpuQuantityType"
+}
+
+// ValueFromString - returns a quantity value given a StringValue.
+func (t quantityType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return quantityValue{StringValue: in, memory: t.memory}, nil
+}
+
+// ValueFromTerraform - returns a quantity value given a tftypes.Value.
+func (t quantityType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	value, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return value, nil
+}
+
+// ValueType - returns the value type of this type.
+func (t quantityType) ValueType(_ context.Context) attr.Value {
+	return quantityValue{memory: t.memory}
+}
+
+// quantityValue - CPU or memory size value, see quantityType.
+type quantityValue struct {
+	basetypes.StringValue
+	memory bool
+}
+
+// newCPUValue - returns a known CPU quantity value.
+func newCPUValue(s string) quantityValue {
+	return quantityValue{StringValue: basetypes.NewStringValue(s)}
+}
+
+// newMemoryValue - returns a known memory quantity value.
+func newMemoryValue(s string) quantityValue {
+	return quantityValue{StringValue: basetypes.NewStringValue(s), memory: true}
+}
+
+// Type - returns the quantity type of the value.
+func (v quantityValue) Type(_ context.Context) attr.Type {
+	return quantityType{memory: v.memory}
+}
+
+// Equal - returns true if the given value is exactly the same.
+func (v quantityValue) Equal(o attr.Value) bool {
+	other, ok := o.(quantityValue)
+	return ok && v.memory == other.memory && v.StringValue.Equal(other.StringValue)
+}
+
+// Normalized - returns the value in the API canonical units.
+func (v quantityValue) Normalized() (string, error) {
+	if v.memory {
+		return client.NormalizeMemory(v.ValueString())
+	}
+	return client.NormalizeCPU(v.ValueString())
+}
+
+// StringSemanticEquals - returns true if both values are the same size, e.g. `16Gi` and `16384`.
+func (v quantityValue) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(quantityValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	prior, err := v.Normalized()
+	if err != nil {
+		return false, nil
+	}
+	current, err := newValue.Normalized()
+	if err != nil {
+		return false, nil
+	}
+
+	return prior == current, nil
+}
+
+// quantityPlanModifier - keeps the prior state value when the configured size is the same
+// size written differently, so switching between `16384` and `16Gi` plans no change.
+type quantityPlanModifier struct {
+	memory bool
+}
+
+// Description - returns a plain text description of the plan modifier.
+func (m quantityPlanModifier) Description(_ context.Context) string {
+	return "Keeps the prior state value if the configured quantity is the same size."
+}
+
+// MarkdownDescription - returns a markdown description of the plan modifier.
+func (m quantityPlanModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+// PlanModifyString - runs the plan modification.
+func (m quantityPlanModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.StateValue.IsNull() || req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	prior := quantityValue{StringValue: req.StateValue, memory: m.memory}
+	config := quantityValue{StringValue: req.ConfigValue, memory: m.memory}
+
+	equal, diags := prior.StringSemanticEquals(ctx, config)
+	resp.Diagnostics.Append(diags...)
+	if equal {
+		resp.PlanValue = req.StateValue
+	}
+}
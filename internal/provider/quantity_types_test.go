@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestQuantitySemanticEquals(t *testing.T) {
+	tests := []struct {
+		prior   quantityValue
+		current quantityValue
+		equal   bool
+	}{
+		{newCPUValue("0.5"), newCPUValue("500m"), true},
+		{newCPUValue("4"), newCPUValue("4000m"), true},
+		{newCPUValue("4"), newCPUValue("2"), false},
+		{newMemoryValue("16384"), newMemoryValue("16Gi"), true},
+		{newMemoryValue("1024"), newMemoryValue("1Gi"), true},
+		{newMemoryValue("16384"), newMemoryValue("8Gi"), false},
+		{newMemoryValue("16384"), newMemoryValue("sixteen"), false},
+	}
+
+	for _, tt := range tests {
+		equal, diags := tt.prior.StringSemanticEquals(context.Background(), tt.current)
+		assert.False(t, diags.HasError())
+		assert.Equal(t, tt.equal, equal, "%s and %s", tt.prior, tt.current)
+	}
+}
+
+func TestQuantityPlanModifier(t *testing.T) {
+	tests := []struct {
+		modifier quantityPlanModifier
+		state    types.String
+		config   types.String
+		want     types.String
+	}{
+		{quantityPlanModifier{memory: true}, types.StringValue("16384"), types.StringValue("16Gi"), types.StringValue("16384")},
+		{quantityPlanModifier{memory: true}, types.StringValue("16384"), types.StringValue("32Gi"), types.StringValue("32Gi")},
+		{quantityPlanModifier{}, types.StringValue("0.5"), types.StringValue("500m"), types.StringValue("0.5")},
+		{quantityPlanModifier{}, types.StringNull(), types.StringValue("500m"), types.StringValue("500m")},
+	}
+
+	for _, tt := range tests {
+		req := planmodifier.StringRequest{
+			Path:        path.Root("cpu"),
+			StateValue:  tt.state,
+			ConfigValue: tt.config,
+			PlanValue:   tt.config,
+		}
+		resp := planmodifier.StringResponse{PlanValue: req.PlanValue}
+		tt.modifier.PlanModifyString(context.Background(), req, &resp)
+		assert.False(t, resp.Diagnostics.HasError())
+		assert.Equal(t, tt.want, resp.PlanValue)
+	}
+}
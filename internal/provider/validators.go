@@ -0,0 +1,340 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/tatari-tv/terraform-provider-altinitycloud/cmd/client"
+	"net"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	// embed the timezone database, so timezones validate on hosts without one
+	_ "time/tzdata"
+)
+
+// Node type scopes supported by Altinity.Cloud.
+var nodeTypeScopes = []string{"ClickHouse", "Zookeeper"}
+
+// Cloud providers supported by Altinity.Cloud environments.
+var environmentClouds = []string{"aws", "gcp", "azure"}
+
+// Compression formats supported by Altinity.Cloud backups.
+var backupCompressions = []string{"tar", "gzip", "lz4", "zstd", "brotli", "bzip2", "xz"}
+
+// Uptime schedule modes supported by Altinity.Cloud clusters.
+var scheduleModes = []string{client.ScheduleModeAlwaysOn, client.ScheduleModeStopWhenInactive, client.ScheduleModeWeekly}
+
+// Days of the week accepted by weekly cluster schedules.
+var scheduleWeekdays = []string{"monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday"}
+
+// Kubernetes toleration operators and effects.
+var (
+	tolerationOperators = []string{"Equal", "Exists"}
+	tolerationEffects   = []string{"NoSchedule", "PreferNoSchedule", "NoExecute"}
+)
+
+// storageClassRegexp - Kubernetes object name (DNS subdomain) as used by storage classes.
+var storageClassRegexp = regexp.MustCompile(`^[a-z0-9]([-a-z0-9.]*[a-z0-9])?$`)
+
+// storageClassValidators - validates the Kubernetes storage class name format.
+func storageClassValidators() []validator.String {
+	return []validator.String{
+		stringvalidator.LengthAtMost(253),
+		stringvalidator.RegexMatches(storageClassRegexp, "must be a valid Kubernetes storage class name, e.g. `gp3`"),
+	}
+}
+
+// clickHouseSettingRegexp - ClickHouse setting name, e.g. `max_memory_usage`.
+var clickHouseSettingRegexp = regexp.MustCompile(`^[a-z_][a-z0-9_]*$`)
+
+// cronField - allowed values of a cron schedule field, with optional names of the values.
+type cronField struct {
+	name     string
+	min, max int
+	names    []string
+}
+
+// cronFields - fields of a five field cron schedule, day of week 7 is Sunday like 0.
+var cronFields = []cronField{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12, names: []string{"jan", "feb", "mar", "apr", "may", "jun", "jul", "aug", "sep", "oct", "nov", "dec"}},
+	{name: "day of week", min: 0, max: 7, names: []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}},
+}
+
+// parseCron - checks that a cron schedule has five fields, each a comma separated list of `*`, values
+// or ranges like `1-5`, optionally with a step like `*/15`, and all values within the field range.
+func parseCron(schedule string) error {
+	fields := strings.Split(schedule, " ")
+	if len(fields) != len(cronFields) {
+		return fmt.Errorf("expected %d fields separated by single spaces, got %d", len(cronFields), len(fields))
+	}
+	for i, field := range fields {
+		f := cronFields[i]
+		for _, item := range strings.Split(field, ",") {
+			if err := f.parseItem(item); err != nil {
+				return fmt.Errorf("%s field %q: %w", f.name, field, err)
+			}
+		}
+	}
+	return nil
+}
+
+// parseItem - checks a single list item of the field.
+func (f cronField) parseItem(item string) error {
+	values, step, hasStep := strings.Cut(item, "/")
+	if hasStep {
+		n, err := strconv.Atoi(step)
+		if err != nil || n < 1 || n > f.max {
+			return fmt.Errorf("step %q must be a number from 1 to %d", step, f.max)
+		}
+	}
+	if values == "*" {
+		return nil
+	}
+
+	low, high, isRange := strings.Cut(values, "-")
+	from, err := f.parseValue(low)
+	if err != nil {
+		return err
+	}
+	if !isRange {
+		return nil
+	}
+	to, err := f.parseValue(high)
+	if err != nil {
+		return err
+	}
+	if from > to {
+		return fmt.Errorf("range %s is reversed", values)
+	}
+	return nil
+}
+
+// parseValue - parses a number or a name of the field and checks its range.
+func (f cronField) parseValue(value string) (int, error) {
+	if i := slices.Index(f.names, strings.ToLower(value)); i >= 0 {
+		return f.min + i, nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil || n < f.min || n > f.max {
+		return 0, fmt.Errorf("value %q must be a number from %d to %d", value, f.min, f.max)
+	}
+	return n, nil
+}
+
+// timeOfDayRegexp - 24-hour time of day, e.g. `08:00` or `20:30`.
+var timeOfDayRegexp = regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`)
+
+// quantityValidator - validates that a string is a number or a Kubernetes resource quantity.
+type quantityValidator struct {
+	parse func(string) (float64, error)
+	unit  string
+}
+
+var _ validator.String = quantityValidator{}
+
+// cpuQuantity - validates CPU sizes such as `4`, `0.5` or `500m`.
+func cpuQuantity() validator.String {
+	return quantityValidator{parse: client.ParseCPU, unit: "cores"}
+}
+
+// memoryQuantity - validates memory sizes such as `16384` (MB) or `16Gi`.
+func memoryQuantity() validator.String {
+	return quantityValidator{parse: client.ParseMemory, unit: "MB"}
+}
+
+// Description - returns a plain text description of the validator.
+func (v quantityValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be a number of %s or a Kubernetes quantity", v.unit)
+}
+
+// MarkdownDescription - returns a markdown description of the validator.
+func (v quantityValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString - runs the validation.
+func (v quantityValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := v.parse(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Quantity",
+			fmt.Sprintf("Attribute %s %s, got: %s", req.Path, v.Description(ctx), err),
+		)
+	}
+}
+
+// networkValidator - validates that a string is an IP address or a CIDR block.
+type networkValidator struct {
+	// canonical - rejects CIDR blocks with host bits set, like `10.0.0.1/8`, which the API stores
+	// as their network address.
+	canonical bool
+}
+
+var _ validator.String = networkValidator{}
+
+// ipOrCIDR - validates networks such as `10.0.0.0/8`, `192.168.1.1` or `::/0`.
+func ipOrCIDR() validator.String {
+	return networkValidator{}
+}
+
+// ipOrCanonicalCIDR - validates networks like ipOrCIDR and requires CIDR blocks to start at their network address.
+func ipOrCanonicalCIDR() validator.String {
+	return networkValidator{canonical: true}
+}
+
+// Description - returns a plain text description of the validator.
+func (v networkValidator) Description(_ context.Context) string {
+	return "value must be an IPv4 or IPv6 address or CIDR block"
+}
+
+// MarkdownDescription - returns a markdown description of the validator.
+func (v networkValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString - runs the validation.
+func (v networkValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if net.ParseIP(value) != nil {
+		return
+	}
+	_, network, err := net.ParseCIDR(value)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Network",
+			fmt.Sprintf("Attribute %s %s, got: %s", req.Path, v.Description(ctx), value),
+		)
+		return
+	}
+
+	if v.canonical && network.String() != value {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Network",
+			fmt.Sprintf("Attribute %s must be written as its network address %s, got: %s", req.Path, network, value),
+		)
+	}
+}
+
+// timestampValidator - validates that a string is an RFC 3339 timestamp.
+type timestampValidator struct{}
+
+var _ validator.String = timestampValidator{}
+
+// rfc3339 - validates timestamps such as `2024-05-01T03:00:00Z`.
+func rfc3339() validator.String {
+	return timestampValidator{}
+}
+
+// Description - returns a plain text description of the validator.
+func (v timestampValidator) Description(_ context.Context) string {
+	return "value must be an RFC 3339 timestamp, e.g. 2024-05-01T03:00:00Z"
+}
+
+// MarkdownDescription - returns a markdown description of the validator.
+func (v timestampValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString - runs the validation.
+func (v timestampValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := time.Parse(time.RFC3339, req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Timestamp",
+			fmt.Sprintf("Attribute %s %s, got: %s", req.Path, v.Description(ctx), req.ConfigValue.ValueString()),
+		)
+	}
+}
+
+// cronValidator - validates that a string is a five field cron schedule.
+type cronValidator struct{}
+
+var _ validator.String = cronValidator{}
+
+// cronSchedule - validates cron schedules such as `0 3 * * *` or `*/15 8-18 * * mon-fri`.
+func cronSchedule() validator.String {
+	return cronValidator{}
+}
+
+// Description - returns a plain text description of the validator.
+func (v cronValidator) Description(_ context.Context) string {
+	return "value must be a cron expression with five fields, e.g. 0 3 * * *"
+}
+
+// MarkdownDescription - returns a markdown description of the validator.
+func (v cronValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString - runs the validation.
+func (v cronValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := parseCron(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Cron Schedule",
+			fmt.Sprintf("Attribute %s %s, got: %s", req.Path, v.Description(ctx), err),
+		)
+	}
+}
+
+// timezoneValidator - validates that a string is an IANA timezone name.
+type timezoneValidator struct{}
+
+var _ validator.String = timezoneValidator{}
+
+// timezone - validates timezones such as `UTC` or `America/New_York`.
+func timezone() validator.String {
+	return timezoneValidator{}
+}
+
+// Description - returns a plain text description of the validator.
+func (v timezoneValidator) Description(_ context.Context) string {
+	return "value must be an IANA timezone name, e.g. America/New_York"
+}
+
+// MarkdownDescription - returns a markdown description of the validator.
+func (v timezoneValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString - runs the validation.
+func (v timezoneValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	// time.LoadLocation treats an empty name as UTC and accepts "Local"
+	value := req.ConfigValue.ValueString()
+	if _, err := time.LoadLocation(value); err != nil || value == "" || value == "Local" {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Timezone",
+			fmt.Sprintf("Attribute %s %s, got: %s", req.Path, v.Description(ctx), value),
+		)
+	}
+}
@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestQuantityValidator(t *testing.T) {
+	tests := []struct {
+		validator validator.String
+		value     types.String
+		valid     bool
+	}{
+		{cpuQuantity(), types.StringValue("4"), true},
+		{cpuQuantity(), types.StringValue("500m"), true},
+		{cpuQuantity(), types.StringValue("four"), false},
+		{memoryQuantity(), types.StringValue("16384"), true},
+		{memoryQuantity(), types.StringValue("16Gi"), true},
+		{memoryQuantity(), types.StringValue("16 GB"), false},
+		{memoryQuantity(), types.StringNull(), true},
+		{memoryQuantity(), types.StringUnknown(), true},
+	}
+
+	for _, tt := range tests {
+		req := validator.StringRequest{Path: path.Root("cpu"), ConfigValue: tt.value}
+		resp := validator.StringResponse{}
+		tt.validator.ValidateString(context.Background(), req, &resp)
+		assert.Equal(t, tt.valid, !resp.Diagnostics.HasError(), "value %s", tt.value)
+	}
+}
+
+func TestStorageClassRegexp(t *testing.T) {
+	for _, sc := range []string{"gp2", "gp3", "premium-rwo", "ebs.csi.aws.com"} {
+		assert.True(t, storageClassRegexp.MatchString(sc), sc)
+	}
+	for _, sc := range []string{"", "GP3", "gp3-", "-gp3", "gp 3", "gp_3"} {
+		assert.False(t, storageClassRegexp.MatchString(sc), sc)
+	}
+}
+
+func TestNetworkValidator(t *testing.T) {
+	tests := []struct {
+		validator validator.String
+		value     types.String
+		valid     bool
+	}{
+		{ipOrCIDR(), types.StringValue("10.0.0.0/8"), true},
+		{ipOrCIDR(), types.StringValue("10.0.0.1/8"), true},
+		{ipOrCIDR(), types.StringValue("192.168.1.1"), true},
+		{ipOrCIDR(), types.StringValue("10.0.0.0/33"), false},
+		{ipOrCanonicalCIDR(), types.StringValue("10.0.0.0/8"), true},
+		{ipOrCanonicalCIDR(), types.StringValue("2001:db8::/32"), true},
+		{ipOrCanonicalCIDR(), types.StringValue("192.168.1.1"), true},
+		{ipOrCanonicalCIDR(), types.StringValue("10.0.0.1/8"), false},
+		{ipOrCanonicalCIDR(), types.StringValue("2001:DB8::/32"), false},
+		{ipOrCanonicalCIDR(), types.StringValue("office"), false},
+		{ipOrCanonicalCIDR(), types.StringUnknown(), true},
+	}
+
+	for _, tt := range tests {
+		req := validator.StringRequest{Path: path.Root("cidr"), ConfigValue: tt.value}
+		resp := validator.StringResponse{}
+		tt.validator.ValidateString(context.Background(), req, &resp)
+		assert.Equal(t, tt.valid, !resp.Diagnostics.HasError(), "value %s", tt.value)
+	}
+}
+
+func TestTimestampValidator(t *testing.T) {
+	tests := []struct {
+		value types.String
+		valid bool
+	}{
+		{types.StringValue("2024-05-01T03:00:00Z"), true},
+		{types.StringValue("2024-05-01T03:00:00+02:00"), true},
+		{types.StringValue("2024-05-01"), false},
+		{types.StringValue("yesterday"), false},
+		{types.StringNull(), true},
+	}
+
+	for _, tt := range tests {
+		req := validator.StringRequest{Path: path.Root("backup_timestamp"), ConfigValue: tt.value}
+		resp := validator.StringResponse{}
+		rfc3339().ValidateString(context.Background(), req, &resp)
+		assert.Equal(t, tt.valid, !resp.Diagnostics.HasError(), "value %s", tt.value)
+	}
+}
+
+func TestTimezoneValidator(t *testing.T) {
+	tests := []struct {
+		value types.String
+		valid bool
+	}{
+		{types.StringValue("UTC"), true},
+		{types.StringValue("America/New_York"), true},
+		{types.StringValue("Mars/Olympus_Mons"), false},
+		{types.StringValue("Local"), false},
+		{types.StringValue(""), false},
+		{types.StringNull(), true},
+	}
+
+	for _, tt := range tests {
+		req := validator.StringRequest{Path: path.Root("timezone"), ConfigValue: tt.value}
+		resp := validator.StringResponse{}
+		timezone().ValidateString(context.Background(), req, &resp)
+		assert.Equal(t, tt.valid, !resp.Diagnostics.HasError(), "value %s", tt.value)
+	}
+}
+
+func TestCronValidator(t *testing.T) {
+	tests := []struct {
+		value types.String
+		valid bool
+	}{
+		{types.StringValue("0 3 * * *"), true},
+		{types.StringValue("*/15 8-18 * * mon-fri"), true},
+		{types.StringValue("0,30 0-23/2 1,15 JAN-DEC/3 0-7"), true},
+		{types.StringValue("5/10 * * * sun"), true},
+		{types.StringNull(), true},
+		{types.StringUnknown(), true},
+		{types.StringValue("0 3 * *"), false},
+		{types.StringValue("0 3 * * * *"), false},
+		{types.StringValue("0  3 * * *"), false},
+		{types.StringValue("60 3 * * *"), false},
+		{types.StringValue("0 24 * * *"), false},
+		{types.StringValue("0 3 0 * *"), false},
+		{types.StringValue("0 3 * 13 *"), false},
+		{types.StringValue("0 3 * * 8"), false},
+		{types.StringValue("0 3 * * funday"), false},
+		{types.StringValue("0 18-8 * * *"), false},
+		{types.StringValue("*/0 3 * * *"), false},
+		{types.StringValue("0 3 1,,15 * *"), false},
+		{types.StringValue("a b c d e"), false},
+		{types.StringValue("0 3 -1 * *"), false},
+	}
+
+	for _, tt := range tests {
+		req := validator.StringRequest{Path: path.Root("schedule"), ConfigValue: tt.value}
+		resp := validator.StringResponse{}
+		cronSchedule().ValidateString(context.Background(), req, &resp)
+		assert.Equal(t, tt.valid, !resp.Diagnostics.HasError(), "value %s", tt.value)
+	}
+}